@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains the managed resources of the database API group.
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Group, Version, and Kind strings for the database API group.
+const (
+	Group   = "database.gcp.crossplane.io"
+	Version = "v1alpha2"
+
+	CloudsqlInstanceKind           = "CloudsqlInstance"
+	CloudsqlInstanceKindAPIVersion = CloudsqlInstanceKind + "." + Version
+)
+
+// CloudsqlInstanceGroupVersionKind is the GroupVersionKind of the
+// CloudsqlInstance resource.
+var CloudsqlInstanceGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: CloudsqlInstanceKind}
+
+// CloudSQL instance states, mirroring the sqladmin.DatabaseInstance.State
+// enum.
+const (
+	StateRunnable       = "RUNNABLE"
+	StateCreating       = "PENDING_CREATE"
+	StateCreationFailed = "FAILED"
+	StateSuspended      = "SUSPENDED"
+	StateMaintenance    = "MAINTENANCE"
+	StateUnknownState   = "UNKNOWN_STATE"
+)
+
+// IP address types, mirroring sqladmin.IpMapping.Type.
+const (
+	PrivateIPType = "PRIVATE"
+	PublicIPType  = "PRIMARY"
+)
+
+// Connection secret keys for the IP addresses published by a
+// CloudsqlInstance, in addition to the standard keys defined by
+// crossplane-runtime.
+const (
+	PrivateIPKey = "privateIP"
+	PublicIPKey  = "publicIP"
+)
+
+// PasswordLength is the length of auto-generated user passwords.
+const PasswordLength = 20
+
+// GCPCloudsqlInstanceParameters define the desired state of a Cloud SQL
+// instance in GCP.
+type GCPCloudsqlInstanceParameters struct {
+	// Tier is the machine tier, e.g. db-n1-standard-1.
+	Tier string `json:"tier"`
+
+	// Region in which the instance is provisioned.
+	Region string `json:"region"`
+
+	// DatabaseVersion of the instance, e.g. MYSQL_5_7.
+	DatabaseVersion string `json:"databaseVersion,omitempty"`
+
+	// StorageGB is the size of the instance's data disk, in GiB.
+	// +optional
+	StorageGB *int64 `json:"storageGB,omitempty"`
+
+	// PrivateIPType enables a private IP on this instance when set to
+	// "PRIVATE".
+	// +optional
+	PrivateIPType string `json:"privateIPType,omitempty"`
+}
+
+// CloudsqlInstanceSpec defines the desired state of a CloudsqlInstance.
+type CloudsqlInstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GCPCloudsqlInstanceParameters `json:"forProvider"`
+}
+
+// GCPCloudsqlInstanceObservation is the observed state of a Cloud SQL
+// instance in GCP.
+type GCPCloudsqlInstanceObservation struct {
+	State       string      `json:"state,omitempty"`
+	IPAddresses []IPAddress `json:"ipAddresses,omitempty"`
+}
+
+// IPAddress is a single IP address assigned to a Cloud SQL instance.
+type IPAddress struct {
+	IPAddress string `json:"ipAddress,omitempty"`
+	Type      string `json:"type,omitempty"`
+}
+
+// CloudsqlInstanceStatus represents the observed state of a CloudsqlInstance.
+type CloudsqlInstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GCPCloudsqlInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudsqlInstance is a managed resource that represents a Google CloudSQL
+// instance.
+type CloudsqlInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudsqlInstanceSpec   `json:"spec,omitempty"`
+	Status CloudsqlInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudsqlInstanceList contains a list of CloudsqlInstance.
+type CloudsqlInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudsqlInstance `json:"items"`
+}
+
+// DatabaseUserName is the name of this instance's root user, e.g.
+// "root" for MySQL or "postgres" for PostgreSQL instances.
+func (c *CloudsqlInstance) DatabaseUserName() string {
+	if strings.HasPrefix(c.Spec.ForProvider.DatabaseVersion, "POSTGRES") {
+		return "postgres"
+	}
+	return "root"
+}
+
+// String implements fmt.Stringer for CloudsqlInstance, used in log messages.
+func (c *CloudsqlInstance) String() string {
+	return fmt.Sprintf("%s/%s", c.Namespace, c.Name)
+}
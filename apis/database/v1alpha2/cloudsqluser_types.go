@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+const (
+	CloudsqlUserKind           = "CloudsqlUser"
+	CloudsqlUserKindAPIVersion = CloudsqlUserKind + "." + Version
+)
+
+// CloudsqlUserGroupVersionKind is the GroupVersionKind of the CloudsqlUser
+// resource.
+var CloudsqlUserGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: CloudsqlUserKind}
+
+// CloudsqlUserParameters define the desired state of a Cloud SQL database
+// user in GCP.
+type CloudsqlUserParameters struct {
+	// InstanceRef references the CloudsqlInstance this user belongs to.
+	InstanceRef corev1.LocalObjectReference `json:"instanceRef"`
+
+	// Name of the user.
+	Name string `json:"name"`
+
+	// Host the user is allowed to connect from, e.g. "%" for any host.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains this
+	// user's password. If omitted, a password is generated and published to
+	// WriteConnectionSecretToReference instead.
+	// +optional
+	PasswordSecretRef *corev1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// CloudsqlUserSpec defines the desired state of a CloudsqlUser.
+type CloudsqlUserSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CloudsqlUserParameters `json:"forProvider"`
+}
+
+// CloudsqlUserObservation is the observed state of a Cloud SQL database user
+// in GCP.
+type CloudsqlUserObservation struct {
+	Host string `json:"host,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	// PasswordChecksum is a SHA-256 digest of the password that was last
+	// successfully applied to this user. The sqladmin API never returns a
+	// user's password, so this is what lets us detect that
+	// PasswordSecretRef's value has changed since the last Update.
+	PasswordChecksum string `json:"passwordChecksum,omitempty"`
+}
+
+// CloudsqlUserStatus represents the observed state of a CloudsqlUser.
+type CloudsqlUserStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CloudsqlUserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudsqlUser is a managed resource that represents a database user on a
+// Google CloudSQL instance.
+type CloudsqlUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudsqlUserSpec   `json:"spec,omitempty"`
+	Status CloudsqlUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudsqlUserList contains a list of CloudsqlUser.
+type CloudsqlUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudsqlUser `json:"items"`
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains the core API types for the GCP stack, including
+// the Provider resource that every managed resource references in order to
+// authenticate against the Google Cloud APIs.
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ClientOptions allows callers to override how clients for the Google Cloud
+// APIs are constructed. It primarily exists to support testing against
+// emulators and private/on-prem API endpoints.
+type ClientOptions struct {
+	// Endpoint overrides the default base URL used by generated Google API
+	// clients, e.g. to point at an emulator or private API endpoint.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// WithoutAuthentication disables credential lookup entirely and issues
+	// unauthenticated requests. Only useful against emulators.
+	// +optional
+	WithoutAuthentication *bool `json:"withoutAuthentication,omitempty"`
+}
+
+// CredentialsSource identifies how a Provider obtains GCP credentials.
+type CredentialsSource string
+
+const (
+	// CredentialsSourceSecret authenticates using a service account JSON key
+	// stored in a Kubernetes Secret. This is the default.
+	CredentialsSourceSecret CredentialsSource = "Secret"
+
+	// CredentialsSourceWorkloadIdentityFederation exchanges an external
+	// subject token for short-lived GCP credentials via Workload Identity
+	// Federation, without any static service account key.
+	CredentialsSourceWorkloadIdentityFederation CredentialsSource = "WorkloadIdentityFederation"
+
+	// CredentialsSourceGKEWorkloadIdentity authenticates using the GSA bound
+	// to the calling pod's KSA via GKE Workload Identity.
+	CredentialsSourceGKEWorkloadIdentity CredentialsSource = "GKEWorkloadIdentity"
+)
+
+// SubjectTokenSource identifies where the external subject token used to
+// authenticate to Workload Identity Federation is read from. Exactly one
+// field must be set.
+type SubjectTokenSource struct {
+	// File is a path to a file containing the subject token.
+	// +optional
+	File *string `json:"file,omitempty"`
+
+	// URL is an endpoint that returns the subject token, e.g. a cloud
+	// provider's instance metadata service.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// AWS sources the subject token from the AWS Instance Metadata Service,
+	// signing a GetCallerIdentity request with the instance's AWS
+	// credentials.
+	// +optional
+	AWS *AWSSubjectTokenSource `json:"aws,omitempty"`
+
+	// ServiceAccountRef mints a projected token for the named in-cluster
+	// ServiceAccount (in the Provider's namespace) via the TokenRequest API.
+	// +optional
+	ServiceAccountRef *corev1.LocalObjectReference `json:"serviceAccountRef,omitempty"`
+}
+
+// AWSSubjectTokenSource configures sourcing the Workload Identity Federation
+// subject token from the AWS Instance Metadata Service. Both fields default
+// to the standard IMDS endpoints and only need to be set to target a
+// non-default metadata service, e.g. in tests.
+type AWSSubjectTokenSource struct {
+	// RegionURL is the IMDS endpoint that returns the instance's AWS region.
+	// Defaults to the standard IMDS region endpoint.
+	// +optional
+	RegionURL *string `json:"regionURL,omitempty"`
+
+	// URL is the IMDS endpoint that returns the instance's security
+	// credentials. Defaults to the standard IMDS security-credentials
+	// endpoint.
+	// +optional
+	URL *string `json:"url,omitempty"`
+}
+
+// WorkloadIdentity configures exchanging an external identity for GCP
+// credentials via Workload Identity Federation.
+type WorkloadIdentity struct {
+	// AudiencePool is the full resource name of the workload identity pool
+	// provider. It is used as the STS audience.
+	AudiencePool string `json:"audiencePool"`
+
+	// ServiceAccountEmail is the GCP service account impersonated once the
+	// external token has been exchanged.
+	ServiceAccountEmail string `json:"serviceAccountEmail"`
+
+	// TokenURL is the STS token exchange endpoint. Defaults to Google's STS
+	// endpoint when omitted.
+	// +optional
+	TokenURL *string `json:"tokenURL,omitempty"`
+
+	// SubjectTokenSource identifies where the external subject token is read
+	// from.
+	SubjectTokenSource SubjectTokenSource `json:"subjectTokenSource"`
+}
+
+// RateLimitSpec configures per-project, per-service rate limiting for calls
+// this Provider's resources make to GCP APIs.
+type RateLimitSpec struct {
+	// MutatingQPS is the refill rate, in tokens per second, applied to
+	// mutating calls (Create/Insert/Patch/Update/Delete). Defaults to 10.
+	// +optional
+	MutatingQPS *float64 `json:"mutatingQPS,omitempty"`
+
+	// MutatingBurst is the maximum burst size for mutating calls. Defaults
+	// to 20.
+	// +optional
+	MutatingBurst *int `json:"mutatingBurst,omitempty"`
+
+	// ReadQPS is the refill rate, in tokens per second, applied to read
+	// calls (Get/List). Defaults to 50.
+	// +optional
+	ReadQPS *float64 `json:"readQPS,omitempty"`
+
+	// ReadBurst is the maximum burst size for read calls. Defaults to 100.
+	// +optional
+	ReadBurst *int `json:"readBurst,omitempty"`
+}
+
+// ProviderSpec defines the desired state of a Provider.
+type ProviderSpec struct {
+	runtimev1alpha1.ProviderSpec `json:",inline"`
+
+	// ProjectID is the ID of the GCP project this provider targets.
+	ProjectID string `json:"projectID"`
+
+	// Secret containing the service account JSON key to authenticate with.
+	// Only used when CredentialsSource is Secret.
+	// +optional
+	Secret corev1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+
+	// CredentialsSource specifies how this Provider obtains GCP credentials.
+	// Defaults to Secret.
+	// +optional
+	CredentialsSource CredentialsSource `json:"credentialsSource,omitempty"`
+
+	// WorkloadIdentity configures Workload Identity Federation. Required
+	// when CredentialsSource is WorkloadIdentityFederation.
+	// +optional
+	WorkloadIdentity *WorkloadIdentity `json:"workloadIdentity,omitempty"`
+
+	// ClientOptions tunes how clients for the Google Cloud APIs are built.
+	// +optional
+	ClientOptions *ClientOptions `json:"clientOptions,omitempty"`
+
+	// RateLimit tunes how aggressively this Provider's resources call GCP
+	// APIs.
+	// +optional
+	RateLimit *RateLimitSpec `json:"rateLimit,omitempty"`
+}
+
+// ProviderStatus represents the observed state of a Provider.
+type ProviderStatus struct {
+	runtimev1alpha1.ConditionedStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// Provider configures a GCP 'provider', i.e. a connection to a particular
+// GCP project using a particular GCP service account.
+type Provider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderSpec   `json:"spec,omitempty"`
+	Status ProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderList contains a list of Provider.
+type ProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Provider `json:"items"`
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	googlecompute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+const (
+	GlobalAddressKind           = "GlobalAddress"
+	GlobalAddressKindAPIVersion = GlobalAddressKind + "." + Version
+)
+
+// GlobalAddressGroupVersionKind is the GroupVersionKind of the GlobalAddress
+// resource.
+var GlobalAddressGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: GlobalAddressKind}
+
+// GCPGlobalAddressSpec defines the desired state of a GlobalAddress in GCP.
+// It currently only supports the shape required to reserve an internal
+// address range for VPC peering, e.g. with the servicenetworking API.
+type GCPGlobalAddressSpec struct {
+	// Name of the global address.
+	Name string `json:"name,omitempty"`
+
+	// Description of this global address.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Purpose of this reservation. VPC_PEERING is currently the only
+	// supported value.
+	// +kubebuilder:validation:Enum=VPC_PEERING
+	Purpose string `json:"purpose"`
+
+	// AddressType is the type of address this reservation holds.
+	// +kubebuilder:validation:Enum=INTERNAL;EXTERNAL
+	AddressType string `json:"addressType"`
+
+	// PrefixLength is the size, in bits, of the mask of the address range.
+	PrefixLength int64 `json:"prefixLength"`
+
+	// NetworkRef references the Network this address range belongs to.
+	// +optional
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a Network by labels to reference.
+	// +optional
+	NetworkSelector *metav1.LabelSelector `json:"networkSelector,omitempty"`
+}
+
+// GCPGlobalAddressStatus represents the observed state of a GlobalAddress in
+// GCP.
+type GCPGlobalAddressStatus struct {
+	SelfLink string `json:"selfLink,omitempty"`
+	Address  string `json:"address,omitempty"`
+}
+
+// GlobalAddressSpec defines the desired state of a GlobalAddress.
+type GlobalAddressSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	GCPGlobalAddressSpec         `json:",inline"`
+}
+
+// GlobalAddressStatus represents the observed state of a GlobalAddress.
+type GlobalAddressStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	GCPGlobalAddressStatus         `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalAddress is a managed resource that represents a reserved Google
+// Compute Engine global internal address range, typically used to set up
+// VPC peering with a Google-managed service such as Cloud SQL.
+type GlobalAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalAddressSpec   `json:"spec,omitempty"`
+	Status GlobalAddressStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalAddressList contains a list of GlobalAddress.
+type GlobalAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalAddress `json:"items"`
+}
+
+// GenerateGlobalAddress generates a *googlecompute.Address from a
+// GCPGlobalAddressSpec and the resolved self-link of its parent Network.
+func GenerateGlobalAddress(spec GCPGlobalAddressSpec, networkSelfLink string) *googlecompute.Address {
+	return &googlecompute.Address{
+		Name:         spec.Name,
+		Description:  spec.Description,
+		Purpose:      spec.Purpose,
+		AddressType:  spec.AddressType,
+		PrefixLength: spec.PrefixLength,
+		Network:      networkSelfLink,
+	}
+}
+
+// GenerateGCPGlobalAddressStatus generates a GCPGlobalAddressStatus from a
+// *googlecompute.Address.
+func GenerateGCPGlobalAddressStatus(a googlecompute.Address) *GCPGlobalAddressStatus {
+	return &GCPGlobalAddressStatus{
+		SelfLink: a.SelfLink,
+		Address:  a.Address,
+	}
+}
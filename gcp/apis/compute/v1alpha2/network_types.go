@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains the Network and Subnetwork managed resources of
+// the compute API group.
+package v1alpha2
+
+import (
+	googlecompute "google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Group, Version, and Kind strings for the compute API group.
+const (
+	Group   = "compute.gcp.crossplane.io"
+	Version = "v1alpha2"
+
+	NetworkKind           = "Network"
+	NetworkKindAPIVersion = NetworkKind + "." + Version
+)
+
+// NetworkGroupVersionKind is the GroupVersionKind of the Network resource.
+var NetworkGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: NetworkKind}
+
+// GCPNetworkSpec defines the desired state of a Network in GCP.
+type GCPNetworkSpec struct {
+	// Name of the network.
+	Name string `json:"name,omitempty"`
+
+	// Description of this network.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// AutoCreateSubnetworks controls whether subnets are created automatically
+	// in each region of the network.
+	// +optional
+	AutoCreateSubnetworks bool `json:"autoCreateSubnetworks,omitempty"`
+
+	// RoutingConfig specifies the network-wide routing mode.
+	// +optional
+	RoutingConfig *GCPNetworkRoutingConfig `json:"routingConfig,omitempty"`
+}
+
+// GCPNetworkRoutingConfig specifies the network-wide routing mode.
+type GCPNetworkRoutingConfig struct {
+	RoutingMode string `json:"routingMode"`
+}
+
+// GCPNetworkStatus represents the observed state of a Network in GCP.
+type GCPNetworkStatus struct {
+	SelfLink  string `json:"selfLink,omitempty"`
+	GatewayIP string `json:"gatewayIPv4,omitempty"`
+}
+
+// NetworkSpec defines the desired state of a Network.
+type NetworkSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	GCPNetworkSpec               `json:",inline"`
+}
+
+// NetworkStatus represents the observed state of a Network.
+type NetworkStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	GCPNetworkStatus               `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// Network is a managed resource that represents a Google Compute Engine VPC
+// Network.
+type Network struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkSpec   `json:"spec,omitempty"`
+	Status NetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkList contains a list of Network.
+type NetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Network `json:"items"`
+}
+
+// GenerateNetwork generates a *googlecompute.Network from a GCPNetworkSpec.
+func GenerateNetwork(spec GCPNetworkSpec) *googlecompute.Network {
+	n := &googlecompute.Network{
+		Name:                  spec.Name,
+		Description:           spec.Description,
+		AutoCreateSubnetworks: spec.AutoCreateSubnetworks,
+	}
+	if spec.RoutingConfig != nil {
+		n.RoutingConfig = &googlecompute.NetworkRoutingConfig{RoutingMode: spec.RoutingConfig.RoutingMode}
+	}
+	return n
+}
+
+// GenerateGCPNetworkStatus generates a GCPNetworkStatus from a
+// *googlecompute.Network.
+func GenerateGCPNetworkStatus(n googlecompute.Network) *GCPNetworkStatus {
+	return &GCPNetworkStatus{
+		SelfLink:  n.SelfLink,
+		GatewayIP: n.GatewayIPv4,
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"reflect"
+
+	googlecompute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+const (
+	SubnetworkKind           = "Subnetwork"
+	SubnetworkKindAPIVersion = SubnetworkKind + "." + Version
+)
+
+// SubnetworkGroupVersionKind is the GroupVersionKind of the Subnetwork
+// resource.
+var SubnetworkGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: SubnetworkKind}
+
+// SecondaryIPRange is a named range of secondary IP addresses for a
+// Subnetwork, e.g. for use by Pods or Services in a GKE cluster.
+type SecondaryIPRange struct {
+	// RangeName is the name of this secondary range.
+	RangeName string `json:"rangeName"`
+
+	// IPCidrRange is the range of IP addresses belonging to this
+	// subnetwork secondary range.
+	IPCidrRange string `json:"ipCidrRange"`
+}
+
+// GCPSubnetworkSpec defines the desired state of a Subnetwork in GCP.
+type GCPSubnetworkSpec struct {
+	// Name of the subnetwork.
+	Name string `json:"name,omitempty"`
+
+	// Description of this subnetwork.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// IPCidrRange is the range of internal addresses owned by this
+	// subnetwork.
+	IPCidrRange string `json:"ipCidrRange"`
+
+	// Region where this subnetwork resides.
+	Region string `json:"region"`
+
+	// PrivateIPGoogleAccess enables VMs in this subnetwork without external
+	// IP addresses to reach Google APIs and services.
+	// +optional
+	PrivateIPGoogleAccess bool `json:"privateIpGoogleAccess,omitempty"`
+
+	// SecondaryIPRanges are additional named ranges of IP addresses for this
+	// subnetwork.
+	// +optional
+	SecondaryIPRanges []SecondaryIPRange `json:"secondaryIpRanges,omitempty"`
+
+	// NetworkRef references the Network this Subnetwork belongs to.
+	// +optional
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a Network by labels to reference.
+	// +optional
+	NetworkSelector *metav1.LabelSelector `json:"networkSelector,omitempty"`
+}
+
+// GCPSubnetworkStatus represents the observed state of a Subnetwork in GCP.
+type GCPSubnetworkStatus struct {
+	SelfLink string `json:"selfLink,omitempty"`
+	Network  string `json:"network,omitempty"`
+}
+
+// SubnetworkSpec defines the desired state of a Subnetwork.
+type SubnetworkSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	GCPSubnetworkSpec            `json:",inline"`
+}
+
+// SubnetworkStatus represents the observed state of a Subnetwork.
+type SubnetworkStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	GCPSubnetworkStatus            `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// Subnetwork is a managed resource that represents a regional Google
+// Compute Engine subnetwork.
+type Subnetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetworkSpec   `json:"spec,omitempty"`
+	Status SubnetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetworkList contains a list of Subnetwork.
+type SubnetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnetwork `json:"items"`
+}
+
+// GenerateSubnetwork generates a *googlecompute.Subnetwork from a
+// GCPSubnetworkSpec and the resolved self-link of its parent Network.
+func GenerateSubnetwork(spec GCPSubnetworkSpec, networkSelfLink string) *googlecompute.Subnetwork {
+	s := &googlecompute.Subnetwork{
+		Name:                  spec.Name,
+		Description:           spec.Description,
+		IpCidrRange:           spec.IPCidrRange,
+		Region:                spec.Region,
+		PrivateIpGoogleAccess: spec.PrivateIPGoogleAccess,
+		Network:               networkSelfLink,
+	}
+	for _, r := range spec.SecondaryIPRanges {
+		s.SecondaryIpRanges = append(s.SecondaryIpRanges, &googlecompute.SubnetworkSecondaryRange{
+			RangeName:   r.RangeName,
+			IpCidrRange: r.IPCidrRange,
+		})
+	}
+	return s
+}
+
+// GenerateGCPSubnetworkStatus generates a GCPSubnetworkStatus from a
+// *googlecompute.Subnetwork.
+func GenerateGCPSubnetworkStatus(s googlecompute.Subnetwork) *GCPSubnetworkStatus {
+	return &GCPSubnetworkStatus{
+		SelfLink: s.SelfLink,
+		Network:  s.Network,
+	}
+}
+
+// IsSubnetworkUpToDate returns true if the supplied Subnetwork appears to
+// reflect the desired state produced by GenerateSubnetwork. Region is
+// excluded from the comparison because it is a short region name in spec
+// but a fully qualified URL in the observed resource.
+func IsSubnetworkUpToDate(spec GCPSubnetworkSpec, observed *googlecompute.Subnetwork) bool {
+	desired := GenerateSubnetwork(spec, observed.Network)
+	desired.Region = ""
+	current := &googlecompute.Subnetwork{
+		Name:                  observed.Name,
+		Description:           observed.Description,
+		IpCidrRange:           observed.IpCidrRange,
+		PrivateIpGoogleAccess: observed.PrivateIpGoogleAccess,
+		Network:               observed.Network,
+		SecondaryIpRanges:     observed.SecondaryIpRanges,
+	}
+	return reflect.DeepEqual(desired, current)
+}
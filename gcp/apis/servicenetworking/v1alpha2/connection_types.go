@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 contains the Connection managed resource of the service
+// networking API group, used to establish VPC peering with Google-managed
+// services such as Cloud SQL.
+package v1alpha2
+
+import (
+	servicenetworking "google.golang.org/api/servicenetworking/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Group, Version, and Kind strings for the servicenetworking API group.
+const (
+	Group   = "servicenetworking.gcp.crossplane.io"
+	Version = "v1alpha2"
+
+	ConnectionKind           = "Connection"
+	ConnectionKindAPIVersion = ConnectionKind + "." + Version
+)
+
+// DefaultService is the peering service used by Cloud SQL private IP and
+// most other Google-managed services that peer into a customer VPC.
+const DefaultService = "servicenetworking.googleapis.com"
+
+// ConnectionGroupVersionKind is the GroupVersionKind of the Connection
+// resource.
+var ConnectionGroupVersionKind = metav1.GroupVersionKind{Group: Group, Version: Version, Kind: ConnectionKind}
+
+// GCPConnectionSpec defines the desired state of a private service
+// networking Connection in GCP.
+type GCPConnectionSpec struct {
+	// Service is the peering service to connect to. Defaults to
+	// servicenetworking.googleapis.com, the service used by Cloud SQL.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// NetworkRef references the Network to peer.
+	// +optional
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a Network by labels to reference.
+	// +optional
+	NetworkSelector *metav1.LabelSelector `json:"networkSelector,omitempty"`
+
+	// ReservedPeeringRangeRefs reference the GlobalAddress resources whose
+	// reserved ranges are used for this peering connection.
+	ReservedPeeringRangeRefs []corev1.LocalObjectReference `json:"reservedPeeringRangeRefs"`
+}
+
+// GCPConnectionStatus represents the observed state of a Connection in GCP.
+type GCPConnectionStatus struct {
+	ReservedPeeringRanges []string `json:"reservedPeeringRanges,omitempty"`
+}
+
+// ConnectionSpec defines the desired state of a Connection.
+type ConnectionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	GCPConnectionSpec            `json:",inline"`
+}
+
+// ConnectionStatus represents the observed state of a Connection.
+type ConnectionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	GCPConnectionStatus            `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// Connection is a managed resource that represents a private VPC peering
+// connection between a Network and a Google-managed service, such as the
+// one Cloud SQL requires for PrivateIPType instances.
+type Connection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectionSpec   `json:"spec,omitempty"`
+	Status ConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionList contains a list of Connection.
+type ConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Connection `json:"items"`
+}
+
+// GenerateConnection generates a *servicenetworking.Connection from a
+// GCPConnectionSpec, the resolved self-link of its parent Network, and the
+// names of its reserved peering ranges.
+func GenerateConnection(spec GCPConnectionSpec, networkSelfLink string, reservedRanges []string) *servicenetworking.Connection {
+	return &servicenetworking.Connection{
+		Network:               networkSelfLink,
+		ReservedPeeringRanges: reservedRanges,
+	}
+}
+
+// GenerateGCPConnectionStatus generates a GCPConnectionStatus from a
+// *servicenetworking.Connection.
+func GenerateGCPConnectionStatus(c servicenetworking.Connection) *GCPConnectionStatus {
+	return &GCPConnectionStatus{ReservedPeeringRanges: c.ReservedPeeringRanges}
+}
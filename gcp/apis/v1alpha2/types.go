@@ -0,0 +1,38 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha2 re-exports the stack-wide Provider type from
+// github.com/crossplaneio/stack-gcp/apis/v1alpha2 for controllers that have
+// not yet migrated off the pre-restructure import path.
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+// Provider configures a GCP 'provider', i.e. a connection to a particular
+// GCP project using a particular GCP service account.
+type Provider = v1alpha2.Provider
+
+// ProviderSpec defines the desired state of a Provider.
+type ProviderSpec = v1alpha2.ProviderSpec
+
+// ProviderStatus represents the observed state of a Provider.
+type ProviderStatus = v1alpha2.ProviderStatus
+
+// ClientOptions allows callers to override how clients for the Google Cloud
+// APIs are constructed.
+type ClientOptions = v1alpha2.ClientOptions
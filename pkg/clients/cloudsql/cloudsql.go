@@ -0,0 +1,74 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudsql provides helpers for converting between the CloudsqlInstance
+// managed resource's spec/status and the sqladmin API's types.
+package cloudsql
+
+import (
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
+)
+
+// GenerateDatabaseInstance generates a *sqladmin.DatabaseInstance from the
+// supplied parameters and external name.
+func GenerateDatabaseInstance(p v1alpha2.GCPCloudsqlInstanceParameters, name string) *sqladmin.DatabaseInstance {
+	i := &sqladmin.DatabaseInstance{
+		Name:            name,
+		Region:          p.Region,
+		DatabaseVersion: p.DatabaseVersion,
+		Settings: &sqladmin.Settings{
+			Tier: p.Tier,
+		},
+	}
+	if p.StorageGB != nil {
+		i.Settings.DataDiskSizeGb = *p.StorageGB
+	}
+	if p.PrivateIPType != "" {
+		i.Settings.IpConfiguration = &sqladmin.IpConfiguration{
+			PrivateNetwork: p.PrivateIPType,
+		}
+	}
+	return i
+}
+
+// GenerateObservation generates a GCPCloudsqlInstanceObservation from the
+// supplied *sqladmin.DatabaseInstance.
+func GenerateObservation(i sqladmin.DatabaseInstance) v1alpha2.GCPCloudsqlInstanceObservation {
+	o := v1alpha2.GCPCloudsqlInstanceObservation{State: i.State}
+	for _, ip := range i.IpAddresses {
+		o.IPAddresses = append(o.IPAddresses, v1alpha2.IPAddress{IPAddress: ip.IpAddress, Type: ip.Type})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unset fields of the supplied spec with values
+// observed on the actual GCP resource.
+func LateInitializeSpec(p *v1alpha2.GCPCloudsqlInstanceParameters, i sqladmin.DatabaseInstance) {
+	if p.DatabaseVersion == "" {
+		p.DatabaseVersion = i.DatabaseVersion
+	}
+	if i.Settings == nil {
+		return
+	}
+	if p.Tier == "" {
+		p.Tier = i.Settings.Tier
+	}
+	if p.StorageGB == nil && i.Settings.DataDiskSizeGb != 0 {
+		p.StorageGB = &i.Settings.DataDiskSizeGb
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/util"
+
+	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
+)
+
+// FindUser returns the user with the given name from the supplied list of
+// users, or nil if no such user exists.
+func FindUser(users []*sqladmin.User, name string) *sqladmin.User {
+	for _, u := range users {
+		if u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+// GenerateUser generates a *sqladmin.User from the supplied parameters. The
+// returned user has no password set; callers that need one (e.g. for
+// Insert) should set User.Password themselves.
+func GenerateUser(p v1alpha2.CloudsqlUserParameters) *sqladmin.User {
+	return &sqladmin.User{
+		Name: p.Name,
+		Host: p.Host,
+	}
+}
+
+// GenerateUserObservation generates a CloudsqlUserObservation from the
+// supplied *sqladmin.User.
+func GenerateUserObservation(u sqladmin.User) v1alpha2.CloudsqlUserObservation {
+	return v1alpha2.CloudsqlUserObservation{Name: u.Name, Host: u.Host}
+}
+
+// HashPassword returns a hex-encoded SHA-256 digest of password, used to
+// detect drift in a password sourced from PasswordSecretRef without
+// persisting the password itself.
+func HashPassword(password string) string {
+	sum := sha256.Sum256([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsUserUpToDate returns true if the observed user matches the desired
+// parameters. The sqladmin API never returns a user's password, so when
+// PasswordSecretRef is set, password drift is instead detected by comparing
+// a hash of desiredPassword against appliedPasswordChecksum, the checksum of
+// the password that was last successfully applied. A generated (unreferenced)
+// password is never considered out of date, since there is nothing for the
+// caller to have changed.
+func IsUserUpToDate(p v1alpha2.CloudsqlUserParameters, u sqladmin.User, desiredPassword, appliedPasswordChecksum string) bool {
+	if p.Host != u.Host {
+		return false
+	}
+	if p.PasswordSecretRef == nil {
+		return true
+	}
+	return HashPassword(desiredPassword) == appliedPasswordChecksum
+}
+
+// UpsertPassword finds the named user in the supplied list, sets its
+// password -- generating one if the caller did not supply one -- and
+// returns the user ready to be passed to sqladmin.UsersService.Update,
+// along with the resolved password.
+func UpsertPassword(users []*sqladmin.User, name, password string) (*sqladmin.User, string, error) {
+	u := FindUser(users, name)
+	if u == nil {
+		return nil, "", &googleapi.Error{
+			Code:    http.StatusNotFound,
+			Message: "user: " + name + " is not found",
+		}
+	}
+	if password == "" {
+		generated, err := util.GeneratePassword(v1alpha2.PasswordLength)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "cannot generate password")
+		}
+		password = generated
+	}
+	u.Password = password
+	return u, password, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsql
+
+import (
+	"testing"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
+)
+
+func TestIsUserUpToDate(t *testing.T) {
+	secretRef := &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "coolSecret"}, Key: "password"}
+
+	cases := map[string]struct {
+		p                       v1alpha2.CloudsqlUserParameters
+		u                       sqladmin.User
+		desiredPassword         string
+		appliedPasswordChecksum string
+		want                    bool
+	}{
+		"HostDiffers": {
+			p:    v1alpha2.CloudsqlUserParameters{Host: "10.0.0.1"},
+			u:    sqladmin.User{Host: "10.0.0.2"},
+			want: false,
+		},
+		"NoPasswordSecretRefAlwaysUpToDate": {
+			p:    v1alpha2.CloudsqlUserParameters{Host: "%"},
+			u:    sqladmin.User{Host: "%"},
+			want: true,
+		},
+		"PasswordSecretRefMatchesAppliedChecksum": {
+			p:                       v1alpha2.CloudsqlUserParameters{Host: "%", PasswordSecretRef: secretRef},
+			u:                       sqladmin.User{Host: "%"},
+			desiredPassword:         "cool-password",
+			appliedPasswordChecksum: HashPassword("cool-password"),
+			want:                    true,
+		},
+		"PasswordSecretRefChangedSincePreviousApply": {
+			p:                       v1alpha2.CloudsqlUserParameters{Host: "%", PasswordSecretRef: secretRef},
+			u:                       sqladmin.User{Host: "%"},
+			desiredPassword:         "new-password",
+			appliedPasswordChecksum: HashPassword("cool-password"),
+			want:                    false,
+		},
+		"PasswordSecretRefNeverApplied": {
+			p:               v1alpha2.CloudsqlUserParameters{Host: "%", PasswordSecretRef: secretRef},
+			u:               sqladmin.User{Host: "%"},
+			desiredPassword: "cool-password",
+			want:            false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUserUpToDate(tc.p, tc.u, tc.desiredPassword, tc.appliedPasswordChecksum)
+			if got != tc.want {
+				t.Errorf("IsUserUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	if HashPassword("a") == HashPassword("b") {
+		t.Errorf("HashPassword(...): want different passwords to hash differently")
+	}
+	if HashPassword("a") != HashPassword("a") {
+		t.Errorf("HashPassword(...): want the same password to hash the same way every time")
+	}
+}
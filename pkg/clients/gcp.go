@@ -0,0 +1,39 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clients provides common helpers shared by GCP managed resource
+// controllers.
+package clients
+
+import (
+	"net/http"
+
+	"google.golang.org/api/googleapi"
+)
+
+// IsErrorNotFound returns true if the supplied error indicates a GCP API
+// resource was not found.
+func IsErrorNotFound(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	return ok && gErr.Code == http.StatusNotFound
+}
+
+// IsErrorAlreadyExists returns true if the supplied error indicates a GCP API
+// resource already exists.
+func IsErrorAlreadyExists(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	return ok && gErr.Code == http.StatusConflict
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gcp provides helpers shared by GCP managed resource controllers
+// that were migrated to the restructured apis/ layout, e.g. the option
+// building used to construct Google API clients.
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+const errProviderSecretNil = "cannot find Secret reference on Provider"
+
+// IsErrorNotFound returns true if the supplied error indicates a GCP API
+// resource was not found.
+func IsErrorNotFound(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	return ok && gErr.Code == http.StatusNotFound
+}
+
+// ProviderCredentials returns Google credentials that can be used to
+// authenticate to the given scopes using the Secret referenced by the
+// supplied Provider.
+func ProviderCredentials(ctx context.Context, kube client.Client, p *apisv1alpha2.Provider, scopes ...string) (*google.Credentials, error) {
+	if p.Spec.Secret.Name == "" {
+		return nil, errors.New(errProviderSecretNil)
+	}
+	secret := &corev1.Secret{}
+	n := meta.NamespacedNameOf(&corev1.ObjectReference{
+		Name:      p.Spec.Secret.Name,
+		Namespace: p.Namespace,
+	})
+	if err := kube.Get(ctx, n, secret); err != nil {
+		return nil, errors.Wrap(err, "cannot get Provider secret")
+	}
+	creds, err := google.CredentialsFromJSON(ctx, secret.Data[p.Spec.Secret.Key], scopes...)
+	return creds, errors.Wrap(err, "cannot parse Provider secret data")
+}
+
+// ClientOptions builds the option.ClientOption slice used to construct a
+// Google API client for the supplied Provider. It honours
+// Provider.Spec.ClientOptions, falling back to Provider.Spec.CredentialsSource
+// to resolve credentials scoped to the supplied scopes. tokens is only
+// consulted when CredentialsSource is WorkloadIdentityFederation and the
+// Provider uses a ServiceAccountRef subject token source; it may be nil
+// otherwise.
+func ClientOptions(ctx context.Context, kube client.Client, tokens TokenRequester, p *apisv1alpha2.Provider, scopes ...string) ([]option.ClientOption, error) {
+	co := p.Spec.ClientOptions
+
+	var opts []option.ClientOption
+	switch {
+	case co != nil && co.WithoutAuthentication != nil && *co.WithoutAuthentication:
+		opts = append(opts, option.WithoutAuthentication(), option.WithHTTPClient(http.DefaultClient))
+	default:
+		creds, err := credentialsForProvider(ctx, kube, tokens, p, scopes...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	if co != nil && co.Endpoint != nil {
+		opts = append(opts, option.WithEndpoint(*co.Endpoint))
+	}
+	return opts, nil
+}
+
+// credentialsForProvider resolves Google credentials according to the
+// supplied Provider's Spec.CredentialsSource.
+func credentialsForProvider(ctx context.Context, kube client.Client, tokens TokenRequester, p *apisv1alpha2.Provider, scopes ...string) (*google.Credentials, error) {
+	switch p.Spec.CredentialsSource {
+	case apisv1alpha2.CredentialsSourceWorkloadIdentityFederation:
+		if p.Spec.WorkloadIdentity == nil {
+			return nil, errors.New(errNoWorkloadIdentity)
+		}
+		return workloadIdentityCredentials(ctx, tokens, p.Namespace, p.Spec.WorkloadIdentity, scopes...)
+	case apisv1alpha2.CredentialsSourceGKEWorkloadIdentity:
+		return google.FindDefaultCredentials(ctx, scopes...)
+	case apisv1alpha2.CredentialsSourceSecret, "":
+		return ProviderCredentials(ctx, kube, p, scopes...)
+	default:
+		return nil, errors.Errorf("unknown CredentialsSource %q", p.Spec.CredentialsSource)
+	}
+}
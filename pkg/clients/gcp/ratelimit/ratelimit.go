@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit provides a per-project, per-service token bucket rate
+// limiter that external clients can use to coordinate calls to GCP APIs,
+// avoiding 429/403 quota storms when a large fleet of managed resources
+// reconciles against the same project.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+// Default limits applied when a Provider does not configure its own.
+const (
+	DefaultMutatingQPS   = 10
+	DefaultMutatingBurst = 20
+	DefaultReadQPS       = 50
+	DefaultReadBurst     = 100
+)
+
+var (
+	apiCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gcp_api_calls_total",
+		Help: "Total number of calls made to a GCP API, labeled by service, verb, and project.",
+	}, []string{"service", "verb", "project"})
+
+	waitSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "gcp_api_ratelimit_wait_seconds",
+		Help: "Time spent waiting for a rate limit token before a GCP API call was issued.",
+	}, []string{"service", "verb", "project"})
+)
+
+func init() {
+	prometheus.MustRegister(apiCallsTotal, waitSeconds)
+}
+
+// Limits configures the burst and refill rate of a token bucket.
+type Limits struct {
+	// QPS is the steady-state number of tokens refilled per second.
+	QPS float64
+
+	// Burst is the maximum number of tokens the bucket can hold.
+	Burst int
+}
+
+// LimitsFromSpec builds the mutating and read Limits used to size a token
+// bucket from the supplied Provider.Spec.RateLimit, falling back to the
+// package defaults for any unset field.
+func LimitsFromSpec(spec *apisv1alpha2.RateLimitSpec) (mutating, read Limits) {
+	mutating = Limits{QPS: DefaultMutatingQPS, Burst: DefaultMutatingBurst}
+	read = Limits{QPS: DefaultReadQPS, Burst: DefaultReadBurst}
+	if spec == nil {
+		return mutating, read
+	}
+	if spec.MutatingQPS != nil {
+		mutating.QPS = *spec.MutatingQPS
+	}
+	if spec.MutatingBurst != nil {
+		mutating.Burst = *spec.MutatingBurst
+	}
+	if spec.ReadQPS != nil {
+		read.QPS = *spec.ReadQPS
+	}
+	if spec.ReadBurst != nil {
+		read.Burst = *spec.ReadBurst
+	}
+	return mutating, read
+}
+
+// IsMutatingVerb returns true if the supplied verb is a mutating GCP API
+// call (as opposed to a read-only Get/List).
+func IsMutatingVerb(verb string) bool {
+	switch verb {
+	case "Create", "Insert", "Patch", "Update", "Delete":
+		return true
+	default:
+		return false
+	}
+}
+
+// key identifies the token bucket backing a particular GCP service within a
+// particular project, separately for mutating and read verbs so that each
+// class is governed by its own configured limits.
+type key struct {
+	projectID string
+	service   string
+	mutating  bool
+}
+
+// Limiter hands out per-project, per-service, per-verb-class token buckets.
+// Multiple Provider CRs that target the same GCP project share the same
+// bucket for a given service and verb class, since the quota they're
+// protecting is shared too. The limits used to size a bucket are taken from
+// whichever call first touches that (project, service, verb class) triple;
+// every later caller of that triple shares the bucket it created.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[key]*rate.Limiter
+}
+
+// NewLimiter returns an empty Limiter.
+func NewLimiter() *Limiter {
+	return &Limiter{buckets: make(map[key]*rate.Limiter)}
+}
+
+var (
+	sharedOnce    sync.Once
+	sharedLimiter *Limiter
+)
+
+// Shared returns the process-wide Limiter. All controllers should use this
+// limiter rather than constructing their own, so that Provider CRs which
+// target the same GCP project share the same per-service token buckets
+// regardless of which managed resource type is reconciling them.
+func Shared() *Limiter {
+	sharedOnce.Do(func() {
+		sharedLimiter = NewLimiter()
+	})
+	return sharedLimiter
+}
+
+func (l *Limiter) bucketFor(projectID, service string, isMutating bool, limits Limits) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	k := key{projectID: projectID, service: service, mutating: isMutating}
+	b, ok := l.buckets[k]
+	if !ok {
+		b = rate.NewLimiter(rate.Limit(limits.QPS), limits.Burst)
+		l.buckets[k] = b
+	}
+	return b
+}
+
+// Wait blocks, respecting ctx cancellation, until a token is available for
+// the supplied project, service, and verb, then records the call and the
+// time spent waiting. mutating and read size the bucket the first time a
+// given (projectID, service, verb class) triple is seen.
+func (l *Limiter) Wait(ctx context.Context, projectID, service, verb string, mutating, read Limits) error {
+	isMutating := IsMutatingVerb(verb)
+	limits := read
+	if isMutating {
+		limits = mutating
+	}
+
+	start := time.Now()
+	b := l.bucketFor(projectID, service, isMutating, limits)
+	if err := b.Wait(ctx); err != nil {
+		return err
+	}
+	waitSeconds.WithLabelValues(service, verb, projectID).Observe(time.Since(start).Seconds())
+	apiCallsTotal.WithLabelValues(service, verb, projectID).Inc()
+	return nil
+}
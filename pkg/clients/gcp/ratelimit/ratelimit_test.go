@@ -0,0 +1,176 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+func float64Ptr(f float64) *float64 { return &f }
+func intPtr(i int) *int             { return &i }
+
+func TestLimitsFromSpec(t *testing.T) {
+	type want struct {
+		mutating Limits
+		read     Limits
+	}
+	cases := map[string]struct {
+		spec *apisv1alpha2.RateLimitSpec
+		want want
+	}{
+		"NilSpecUsesDefaults": {
+			spec: nil,
+			want: want{
+				mutating: Limits{QPS: DefaultMutatingQPS, Burst: DefaultMutatingBurst},
+				read:     Limits{QPS: DefaultReadQPS, Burst: DefaultReadBurst},
+			},
+		},
+		"EmptySpecUsesDefaults": {
+			spec: &apisv1alpha2.RateLimitSpec{},
+			want: want{
+				mutating: Limits{QPS: DefaultMutatingQPS, Burst: DefaultMutatingBurst},
+				read:     Limits{QPS: DefaultReadQPS, Burst: DefaultReadBurst},
+			},
+		},
+		"OverridesAllFields": {
+			spec: &apisv1alpha2.RateLimitSpec{
+				MutatingQPS:   float64Ptr(1),
+				MutatingBurst: intPtr(2),
+				ReadQPS:       float64Ptr(3),
+				ReadBurst:     intPtr(4),
+			},
+			want: want{
+				mutating: Limits{QPS: 1, Burst: 2},
+				read:     Limits{QPS: 3, Burst: 4},
+			},
+		},
+		"OverridesOnlyMutatingQPS": {
+			spec: &apisv1alpha2.RateLimitSpec{MutatingQPS: float64Ptr(1)},
+			want: want{
+				mutating: Limits{QPS: 1, Burst: DefaultMutatingBurst},
+				read:     Limits{QPS: DefaultReadQPS, Burst: DefaultReadBurst},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mutating, read := LimitsFromSpec(tc.spec)
+			if diff := cmp.Diff(tc.want.mutating, mutating); diff != "" {
+				t.Errorf("LimitsFromSpec(...) mutating: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.read, read); diff != "" {
+				t.Errorf("LimitsFromSpec(...) read: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMutatingVerb(t *testing.T) {
+	cases := map[string]struct {
+		verb string
+		want bool
+	}{
+		"Insert": {verb: "Insert", want: true},
+		"Patch":  {verb: "Patch", want: true},
+		"Update": {verb: "Update", want: true},
+		"Delete": {verb: "Delete", want: true},
+		"Get":    {verb: "Get", want: false},
+		"List":   {verb: "List", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsMutatingVerb(tc.verb); got != tc.want {
+				t.Errorf("IsMutatingVerb(%q): want %t, got %t", tc.verb, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLimiterBucketSharing(t *testing.T) {
+	l := NewLimiter()
+	limits := Limits{QPS: 1, Burst: 1}
+
+	a := l.bucketFor("project-a", "compute.googleapis.com", true, limits)
+	b := l.bucketFor("project-a", "compute.googleapis.com", true, limits)
+	if a != b {
+		t.Errorf("bucketFor(same project, same service, same class): want the same *rate.Limiter, got different instances")
+	}
+
+	c := l.bucketFor("project-a", "sqladmin.googleapis.com", true, limits)
+	if a == c {
+		t.Errorf("bucketFor(same project, different service): want different *rate.Limiter instances, got the same one")
+	}
+
+	d := l.bucketFor("project-b", "compute.googleapis.com", true, limits)
+	if a == d {
+		t.Errorf("bucketFor(different project, same service): want different *rate.Limiter instances, got the same one")
+	}
+
+	e := l.bucketFor("project-a", "compute.googleapis.com", false, limits)
+	if a == e {
+		t.Errorf("bucketFor(same project, same service, different verb class): want different *rate.Limiter instances, got the same one")
+	}
+}
+
+func TestLimiterWait(t *testing.T) {
+	l := NewLimiter()
+	mutating := Limits{QPS: 100, Burst: 100}
+	read := Limits{QPS: 100, Burst: 100}
+
+	if err := l.Wait(context.Background(), "project-a", "compute.googleapis.com", "Insert", mutating, read); err != nil {
+		t.Errorf("Wait(...): unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, "project-a", "compute.googleapis.com", "Insert", mutating, read); err == nil {
+		t.Errorf("Wait(cancelled ctx): want an error, got none")
+	}
+}
+
+func TestLimiterWaitEnforcesDistinctClasses(t *testing.T) {
+	l := NewLimiter()
+	mutating := Limits{QPS: 1, Burst: 1}
+	read := Limits{QPS: 100, Burst: 100}
+
+	// Exhaust the single-token mutating bucket for (project, service).
+	if err := l.Wait(context.Background(), "project-a", "compute.googleapis.com", "Insert", mutating, read); err != nil {
+		t.Fatalf("Wait(Insert): unexpected error: %v", err)
+	}
+
+	// A deadline shorter than one refill (1s at 1 QPS) lets us observe,
+	// without blocking the test, whether the next call would have needed to
+	// wait for a refill: rate.Limiter.Wait fails at once when the wait would
+	// outlast the deadline. If the verb class shared a bucket with the
+	// mutating call above, this would also fail.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, "project-a", "compute.googleapis.com", "Get", mutating, read); err != nil {
+		t.Errorf("Wait(Get) after exhausting the mutating bucket: want no error from the independent read bucket, got: %v", err)
+	}
+
+	// The mutating bucket, on the other hand, should still be out of tokens.
+	if err := l.Wait(ctx, "project-a", "compute.googleapis.com", "Insert", mutating, read); err == nil {
+		t.Errorf("Wait(Insert) with exhausted mutating bucket and short deadline: want an error, got none")
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	computev1alpha2 "github.com/crossplaneio/stack-gcp/gcp/apis/compute/v1alpha2"
+)
+
+const errResolveNetwork = "cannot resolve NetworkRef/NetworkSelector"
+
+// ResolveNetwork resolves the Network referenced by name or label selector
+// from the supplied namespace. It mirrors the pattern crossplane-runtime's
+// cross-resource reference resolution uses, ahead of this stack adopting
+// that machinery for every managed resource.
+func ResolveNetwork(ctx context.Context, kube client.Client, namespace string, ref *corev1.LocalObjectReference, sel *metav1.LabelSelector) (*computev1alpha2.Network, error) {
+	switch {
+	case ref != nil:
+		n := &computev1alpha2.Network{}
+		if err := kube.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, n); err != nil {
+			return nil, errors.Wrap(err, errResolveNetwork)
+		}
+		return n, nil
+	case sel != nil:
+		selector, err := metav1.LabelSelectorAsSelector(sel)
+		if err != nil {
+			return nil, errors.Wrap(err, errResolveNetwork)
+		}
+		l := &computev1alpha2.NetworkList{}
+		if err := kube.List(ctx, l, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, errors.Wrap(err, errResolveNetwork)
+		}
+		if len(l.Items) == 0 {
+			return nil, errors.New(errResolveNetwork + ": no Network matched networkSelector")
+		}
+		return &l.Items[0], nil
+	default:
+		return nil, errors.New(errResolveNetwork + ": neither networkRef nor networkSelector is set")
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+const (
+	defaultSTSTokenURL = "https://sts.googleapis.com/v1/token"
+
+	// defaultAWSRegionURL and defaultAWSSecurityCredentialsURL are the
+	// standard AWS Instance Metadata Service endpoints used to source the
+	// subject token when SubjectTokenSource.AWS is set without overrides.
+	defaultAWSRegionURL              = "http://169.254.169.254/latest/meta-data/placement/region"
+	defaultAWSSecurityCredentialsURL = "http://169.254.169.254/latest/meta-data/iam/security-credentials"
+
+	// awsRegionalCredVerificationURL is written into the credential config
+	// verbatim; golang.org/x/oauth2/google's external_account AWS flow
+	// substitutes the "{region}" placeholder with the instance's region.
+	awsRegionalCredVerificationURL = "https://sts.{region}.amazonaws.com?Action=GetCallerIdentity&Version=2011-06-15"
+
+	// saTokenExpirationSeconds is the lifetime, one hour, requested for each
+	// minted ServiceAccount token. A new token is minted and written each
+	// time a managed resource connects, i.e. on every reconcile.
+	saTokenExpirationSeconds = int64(3600)
+
+	errNoWorkloadIdentity   = "Provider.Spec.WorkloadIdentity must be set when CredentialsSource is WorkloadIdentityFederation"
+	errNoSubjectTokenSource = "WorkloadIdentity.SubjectTokenSource must set exactly one of file, url, aws, or serviceAccountRef"
+)
+
+// TokenRequester mints a token for an in-cluster ServiceAccount via the
+// authentication.k8s.io/v1 TokenRequest API.
+type TokenRequester interface {
+	CreateToken(ctx context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error)
+}
+
+// KubernetesTokenRequester adapts a client-go Kubernetes clientset to
+// TokenRequester.
+type KubernetesTokenRequester struct {
+	Clientset kubernetes.Interface
+}
+
+// CreateToken mints a token for the named ServiceAccount via the
+// authentication.k8s.io/v1 TokenRequest API.
+func (k *KubernetesTokenRequester) CreateToken(ctx context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+	return k.Clientset.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, tr, metav1.CreateOptions{})
+}
+
+// externalAccountConfig is the JSON shape consumed by
+// golang.org/x/oauth2/google.CredentialsFromJSON to configure an
+// external_account credential, as documented at
+// https://google.aip.dev/auth/4117.
+type externalAccountConfig struct {
+	Type                           string           `json:"type"`
+	Audience                       string           `json:"audience"`
+	SubjectTokenType               string           `json:"subject_token_type"`
+	TokenURL                       string           `json:"token_url"`
+	ServiceAccountImpersonationURL string           `json:"service_account_impersonation_url"`
+	CredentialSource               credentialSource `json:"credential_source"`
+}
+
+// credentialSource identifies where the external subject token is read
+// from.
+type credentialSource struct {
+	File string `json:"file,omitempty"`
+	URL  string `json:"url,omitempty"`
+
+	// EnvironmentID, RegionURL, and RegionalCredVerificationURL are only set
+	// when sourcing the subject token from the AWS Instance Metadata
+	// Service; URL then points at the IMDS security-credentials endpoint.
+	EnvironmentID               string `json:"environment_id,omitempty"`
+	RegionURL                   string `json:"region_url,omitempty"`
+	RegionalCredVerificationURL string `json:"regional_cred_verification_url,omitempty"`
+}
+
+// workloadIdentityCredentials exchanges the subject token configured on the
+// supplied WorkloadIdentity for short-lived GCP credentials.
+func workloadIdentityCredentials(ctx context.Context, tokens TokenRequester, namespace string, wi *apisv1alpha2.WorkloadIdentity, scopes ...string) (*google.Credentials, error) {
+	source, err := subjectTokenCredentialSource(ctx, tokens, namespace, wi.AudiencePool, wi.SubjectTokenSource)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenURL := defaultSTSTokenURL
+	if wi.TokenURL != nil {
+		tokenURL = *wi.TokenURL
+	}
+
+	cfg := externalAccountConfig{
+		Type:                           "external_account",
+		Audience:                       wi.AudiencePool,
+		SubjectTokenType:               "urn:ietf:params:oauth:token-type:jwt",
+		TokenURL:                       tokenURL,
+		ServiceAccountImpersonationURL: impersonationURL(wi.ServiceAccountEmail),
+		CredentialSource:               source,
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal external account credential config")
+	}
+	creds, err := google.CredentialsFromJSON(ctx, b, scopes...)
+	return creds, errors.Wrap(err, "cannot build external account credentials")
+}
+
+func impersonationURL(email string) string {
+	return fmt.Sprintf("https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken", email)
+}
+
+func subjectTokenCredentialSource(ctx context.Context, tokens TokenRequester, namespace, audience string, sts apisv1alpha2.SubjectTokenSource) (credentialSource, error) {
+	switch {
+	case sts.File != nil:
+		return credentialSource{File: *sts.File}, nil
+	case sts.URL != nil:
+		return credentialSource{URL: *sts.URL}, nil
+	case sts.AWS != nil:
+		return awsCredentialSource(sts.AWS), nil
+	case sts.ServiceAccountRef != nil:
+		path, err := writeProjectedServiceAccountToken(ctx, tokens, namespace, sts.ServiceAccountRef.Name, audience)
+		if err != nil {
+			return credentialSource{}, err
+		}
+		return credentialSource{File: path}, nil
+	}
+	return credentialSource{}, errors.New(errNoSubjectTokenSource)
+}
+
+// awsCredentialSource builds the credential_source that tells Google's
+// external_account flow to source the subject token from the AWS Instance
+// Metadata Service, falling back to the standard IMDS endpoints when aws
+// does not override them.
+func awsCredentialSource(aws *apisv1alpha2.AWSSubjectTokenSource) credentialSource {
+	regionURL := defaultAWSRegionURL
+	if aws.RegionURL != nil {
+		regionURL = *aws.RegionURL
+	}
+	url := defaultAWSSecurityCredentialsURL
+	if aws.URL != nil {
+		url = *aws.URL
+	}
+	return credentialSource{
+		EnvironmentID:               "aws1",
+		RegionURL:                   regionURL,
+		URL:                         url,
+		RegionalCredVerificationURL: awsRegionalCredVerificationURL,
+	}
+}
+
+// writeProjectedServiceAccountToken mints a token for the named in-cluster
+// ServiceAccount, scoped to the Workload Identity Federation audience, and
+// writes it to a tmpfs file that the external_account credential source
+// re-reads on every refresh. The token is written to a per-call temp file
+// and renamed into place, since concurrent reconciles of resources that
+// share this ServiceAccountRef all call this function against the same
+// destination path and controller-runtime reconciles concurrently by
+// default; a direct write could race with another goroutine's in-flight
+// read of the same path.
+func writeProjectedServiceAccountToken(ctx context.Context, tokens TokenRequester, namespace, name, audience string) (string, error) {
+	tr := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{audience},
+			ExpirationSeconds: &saTokenExpirationSeconds,
+		},
+	}
+	tr, err := tokens.CreateToken(ctx, namespace, name, tr)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot mint ServiceAccount token")
+	}
+
+	path := filepath.Join(os.TempDir(), fmt.Sprintf("gcp-wif-%s-%s.jwt", namespace, name))
+	tmp, err := ioutil.TempFile(os.TempDir(), fmt.Sprintf("gcp-wif-%s-%s-*.jwt.tmp", namespace, name))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot create temp file for ServiceAccount token")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write([]byte(tr.Status.Token)); err != nil {
+		tmp.Close() // nolint:errcheck,gosec
+		return "", errors.Wrap(err, "cannot write ServiceAccount token to temp file")
+	}
+	if err := tmp.Close(); err != nil {
+		return "", errors.Wrap(err, "cannot write ServiceAccount token to temp file")
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return "", errors.Wrap(err, "cannot set permissions on ServiceAccount token temp file")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", errors.Wrap(err, "cannot move ServiceAccount token into place")
+	}
+	return path, nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+)
+
+type fakeTokenRequester struct {
+	token string
+	err   error
+}
+
+func (f *fakeTokenRequester) CreateToken(_ context.Context, _, _ string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	tr.Status.Token = f.token
+	return tr, nil
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestSubjectTokenCredentialSource(t *testing.T) {
+	type args struct {
+		tokens    TokenRequester
+		namespace string
+		audience  string
+		sts       apisv1alpha2.SubjectTokenSource
+	}
+	type want struct {
+		source credentialSource
+		errMsg string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"File": {
+			args: args{sts: apisv1alpha2.SubjectTokenSource{File: strPtr("/var/run/token")}},
+			want: want{source: credentialSource{File: "/var/run/token"}},
+		},
+		"URL": {
+			args: args{sts: apisv1alpha2.SubjectTokenSource{URL: strPtr("http://example.com/token")}},
+			want: want{source: credentialSource{URL: "http://example.com/token"}},
+		},
+		"AWSDefaults": {
+			args: args{sts: apisv1alpha2.SubjectTokenSource{AWS: &apisv1alpha2.AWSSubjectTokenSource{}}},
+			want: want{source: credentialSource{
+				EnvironmentID:               "aws1",
+				RegionURL:                   defaultAWSRegionURL,
+				URL:                         defaultAWSSecurityCredentialsURL,
+				RegionalCredVerificationURL: awsRegionalCredVerificationURL,
+			}},
+		},
+		"AWSOverrides": {
+			args: args{sts: apisv1alpha2.SubjectTokenSource{AWS: &apisv1alpha2.AWSSubjectTokenSource{
+				RegionURL: strPtr("http://169.254.169.254/custom/region"),
+				URL:       strPtr("http://169.254.169.254/custom/security-credentials"),
+			}}},
+			want: want{source: credentialSource{
+				EnvironmentID:               "aws1",
+				RegionURL:                   "http://169.254.169.254/custom/region",
+				URL:                         "http://169.254.169.254/custom/security-credentials",
+				RegionalCredVerificationURL: awsRegionalCredVerificationURL,
+			}},
+		},
+		"ServiceAccountRef": {
+			args: args{
+				tokens:    &fakeTokenRequester{token: "minted-token"},
+				namespace: "coolNamespace",
+				audience:  "//iam.googleapis.com/my-pool",
+				sts: apisv1alpha2.SubjectTokenSource{
+					ServiceAccountRef: &corev1.LocalObjectReference{Name: "coolSA"},
+				},
+			},
+			want: want{},
+		},
+		"NoneSet": {
+			args: args{sts: apisv1alpha2.SubjectTokenSource{}},
+			want: want{errMsg: errNoSubjectTokenSource},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := subjectTokenCredentialSource(context.Background(), tc.args.tokens, tc.args.namespace, tc.args.audience, tc.args.sts)
+			if tc.want.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.want.errMsg) {
+					t.Fatalf("subjectTokenCredentialSource(...): want error containing %q, got %v", tc.want.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("subjectTokenCredentialSource(...): unexpected error: %v", err)
+			}
+			if name == "ServiceAccountRef" {
+				// The file path embeds a temp directory, so only assert its
+				// contents and that File was populated rather than an exact
+				// path match.
+				if got.File == "" {
+					t.Fatalf("subjectTokenCredentialSource(...): want a non-empty File path")
+				}
+				b, err := ioutil.ReadFile(got.File)
+				if err != nil {
+					t.Fatalf("ReadFile(%s): unexpected error: %v", got.File, err)
+				}
+				if string(b) != "minted-token" {
+					t.Errorf("file contents: want \"minted-token\", got %q", string(b))
+				}
+				os.Remove(got.File) // nolint:errcheck
+				return
+			}
+			if diff := cmp.Diff(tc.want.source, got); diff != "" {
+				t.Errorf("subjectTokenCredentialSource(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteProjectedServiceAccountTokenConcurrent(t *testing.T) {
+	// Concurrent calls writing the same (namespace, name) token file must all
+	// succeed and leave the file holding the complete token. The file is read
+	// only after every writer has finished.
+	tokens := &fakeTokenRequester{token: strings.Repeat("a", 4096)}
+
+	done := make(chan string, 8)
+	for i := 0; i < 8; i++ {
+		go func() {
+			path, err := writeProjectedServiceAccountToken(context.Background(), tokens, "coolNamespace", "coolSA", "//iam.googleapis.com/my-pool")
+			if err != nil {
+				t.Errorf("writeProjectedServiceAccountToken(...): unexpected error: %v", err)
+			}
+			done <- path
+		}()
+	}
+
+	var path string
+	for i := 0; i < 8; i++ {
+		path = <-done
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%s): unexpected error: %v", path, err)
+	}
+	if string(b) != tokens.token {
+		t.Errorf("file contents were torn by a concurrent write: got %d bytes, want %d", len(b), len(tokens.token))
+	}
+	os.Remove(path) // nolint:errcheck
+}
@@ -19,28 +19,28 @@ package database
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"reflect"
 	"strings"
 
 	"github.com/pkg/errors"
-	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
-	"github.com/crossplaneio/crossplane-runtime/pkg/util"
 
 	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
 	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
 	gcp "github.com/crossplaneio/stack-gcp/pkg/clients"
 	"github.com/crossplaneio/stack-gcp/pkg/clients/cloudsql"
+	clientsgcp "github.com/crossplaneio/stack-gcp/pkg/clients/gcp"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/gcp/ratelimit"
 )
 
 const (
@@ -56,6 +56,10 @@ const (
 	errPatchFailed      = "cannot patch the Cloudsql instance"
 	errGetFailed        = "cannot get the Cloudsql instance"
 	errUpdateRootFailed = "cannot update root user credentials"
+
+	// sqladminService identifies the Cloud SQL Admin API to the shared rate
+	// limiter.
+	sqladminService = "sqladmin.googleapis.com"
 )
 
 // CloudsqlInstanceController is the controller for Cloudsql CRD.
@@ -64,9 +68,17 @@ type CloudsqlInstanceController struct{}
 // SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields
 // on the Controller and Start it when the Manager is Started.
 func (c *CloudsqlInstanceController) SetupWithManager(mgr ctrl.Manager) error {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes clientset")
+	}
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.CloudsqlInstanceGroupVersionKind),
-		resource.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient()}))
+		resource.WithExternalConnecter(&cloudsqlConnector{
+			kube:    mgr.GetClient(),
+			tokens:  &clientsgcp.KubernetesTokenRequester{Clientset: clientset},
+			limiter: ratelimit.Shared(),
+		}))
 
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.CloudsqlInstanceKindAPIVersion, v1alpha2.Group))
 
@@ -78,6 +90,8 @@ func (c *CloudsqlInstanceController) SetupWithManager(mgr ctrl.Manager) error {
 
 type cloudsqlConnector struct {
 	kube         client.Client
+	tokens       clientsgcp.TokenRequester
+	limiter      *ratelimit.Limiter
 	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*sqladmin.Service, error)
 }
 
@@ -92,26 +106,29 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (r
 	if err := c.kube.Get(ctx, n, provider); err != nil {
 		return nil, errors.Wrap(err, errProviderNotRetrieved)
 	}
-	secret := &v1.Secret{}
-	name := meta.NamespacedNameOf(&v1.ObjectReference{
-		Name:      provider.Spec.Secret.Name,
-		Namespace: provider.Namespace,
-	})
-	if err := c.kube.Get(ctx, name, secret); err != nil {
+
+	opts, err := clientsgcp.ClientOptions(ctx, c.kube, c.tokens, provider, sqladmin.SqlserviceAdminScope)
+	if err != nil {
 		return nil, errors.Wrap(err, errProviderSecretNotRetrieved)
 	}
-
 	if c.newServiceFn == nil {
 		c.newServiceFn = sqladmin.NewService
 	}
-	s, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(secret.Data[provider.Spec.Secret.Key]),
-		option.WithScopes(sqladmin.SqlserviceAdminScope))
+	s, err := c.newServiceFn(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &cloudsqlExternal{kube: c.kube, db: s.Instances, user: s.Users, projectID: provider.Spec.ProjectID}, nil
+	mutating, read := ratelimit.LimitsFromSpec(provider.Spec.RateLimit)
+	return &cloudsqlExternal{
+		kube:      c.kube,
+		db:        s.Instances,
+		user:      s.Users,
+		projectID: provider.Spec.ProjectID,
+		limiter:   c.limiter,
+		mutating:  mutating,
+		read:      read,
+	}, nil
 }
 
 type cloudsqlExternal struct {
@@ -119,6 +136,16 @@ type cloudsqlExternal struct {
 	db        *sqladmin.InstancesService
 	user      *sqladmin.UsersService
 	projectID string
+
+	limiter  *ratelimit.Limiter
+	mutating ratelimit.Limits
+	read     ratelimit.Limits
+}
+
+// wait acquires a rate limit token for the supplied Cloud SQL Admin API verb
+// before the caller issues the corresponding API call.
+func (c *cloudsqlExternal) wait(ctx context.Context, verb string) error {
+	return c.limiter.Wait(ctx, c.projectID, sqladminService, verb, c.mutating, c.read)
 }
 
 func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
@@ -126,6 +153,9 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (re
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errNotCloudsql)
 	}
+	if err := c.wait(ctx, "Get"); err != nil {
+		return resource.ExternalObservation{}, err
+	}
 	instance, err := c.db.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return resource.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFailed)
@@ -170,6 +200,9 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (res
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errNotCloudsql)
 	}
+	if err := c.wait(ctx, "Insert"); err != nil {
+		return resource.ExternalCreation{}, err
+	}
 	instance := cloudsql.GenerateDatabaseInstance(cr.Spec.ForProvider, meta.GetExternalName(cr))
 	_, err := c.db.Insert(c.projectID, instance).Context(ctx).Do()
 	return resource.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errInsertFailed)
@@ -184,6 +217,9 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (res
 	if err != nil {
 		return resource.ExternalUpdate{}, errors.Wrap(err, errUpdateRootFailed)
 	}
+	if err := c.wait(ctx, "Patch"); err != nil {
+		return resource.ExternalUpdate{}, err
+	}
 	instance := cloudsql.GenerateDatabaseInstance(cr.Spec.ForProvider, meta.GetExternalName(cr))
 	_, err = c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
 	return resource.ExternalUpdate{ConnectionDetails: conn}, errors.Wrap(err, errPatchFailed)
@@ -194,6 +230,9 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	if !ok {
 		return errors.New(errNotCloudsql)
 	}
+	if err := c.wait(ctx, "Delete"); err != nil {
+		return err
+	}
 	_, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if gcp.IsErrorNotFound(err) {
 		return nil
@@ -233,40 +272,36 @@ func (c *cloudsqlExternal) getConnectionDetails(ctx context.Context, cr *v1alpha
 	return m, nil
 }
 
+// updateRootCredentials reconciles the instance's built-in root user. It
+// finds the user and fills in a missing password through
+// cloudsql.UpsertPassword, which looks users up with the same
+// cloudsql.FindUser helper the CloudsqlUser controller uses and generates
+// passwords of the same v1alpha2.PasswordLength. The Update call is issued
+// here; it does not go through the CloudsqlUser controller's Update.
 func (c *cloudsqlExternal) updateRootCredentials(ctx context.Context, cr *v1alpha2.CloudsqlInstance) (resource.ConnectionDetails, error) {
+	if err := c.wait(ctx, "List"); err != nil {
+		return nil, err
+	}
 	users, err := c.user.List(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
-	var rootUser *sqladmin.User
-	for _, val := range users.Items {
-		if val.Name == cr.DatabaseUserName() {
-			rootUser = val
-			break
-		}
-	}
-	if rootUser == nil {
-		return nil, &googleapi.Error{
-			Code:    http.StatusNotFound,
-			Message: fmt.Sprintf("user: %s is not found", cr.DatabaseUserName()),
-		}
-	}
 	conn, err := c.getConnectionDetails(ctx, cr)
 	if err != nil {
 		return nil, err
 	}
-	password := string(conn[v1alpha1.ResourceCredentialsSecretPasswordKey])
-	if len(password) == 0 {
-		password, err = util.GeneratePassword(v1alpha2.PasswordLength)
-		if err != nil {
-			return nil, err
-		}
-		conn[v1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(password)
+	rootUser, password, err := cloudsql.UpsertPassword(users.Items, cr.DatabaseUserName(), string(conn[v1alpha1.ResourceCredentialsSecretPasswordKey]))
+	if err != nil {
+		return nil, err
+	}
+	conn[v1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(password)
+
+	if err := c.wait(ctx, "Update"); err != nil {
+		return nil, err
 	}
-	rootUser.Password = password
 	_, err = c.user.Update(c.projectID, meta.GetExternalName(cr), rootUser.Name, rootUser).
 		Host(rootUser.Host).
 		Context(ctx).
 		Do()
 	return conn, err
-}
\ No newline at end of file
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/util"
+
+	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/apis/v1alpha2"
+	gcp "github.com/crossplaneio/stack-gcp/pkg/clients"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/cloudsql"
+	clientsgcp "github.com/crossplaneio/stack-gcp/pkg/clients/gcp"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/gcp/ratelimit"
+)
+
+const (
+	errNotCloudsqlUser            = "managed resource is not a CloudsqlUser CR"
+	errUserProviderNotRetrieved   = "provider could not be retrieved"
+	errUserClientOptionsFailed    = "cannot construct client options"
+	errUserPasswordNotRetrieved   = "secret referred in passwordSecretRef could not be retrieved"
+	errUserNewClientFailed        = "cannot create new Sqladmin Service"
+	errUserListFailed             = "cannot list Cloudsql users"
+	errUserInsertFailed           = "cannot insert new Cloudsql user"
+	errUserUpdateFailed           = "cannot update Cloudsql user"
+	errUserDeleteFailed           = "cannot delete Cloudsql user"
+	errUserGeneratePasswordFailed = "cannot generate password"
+	errUserInstanceNotRetrieved   = "referenced CloudsqlInstance could not be retrieved"
+)
+
+// CloudsqlUserController is the controller for CloudsqlUser CRD.
+type CloudsqlUserController struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields
+// on the Controller and Start it when the Manager is Started.
+func (c *CloudsqlUserController) SetupWithManager(mgr ctrl.Manager) error {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes clientset")
+	}
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.CloudsqlUserGroupVersionKind),
+		resource.WithExternalConnecter(&cloudsqlUserConnector{
+			kube:    mgr.GetClient(),
+			tokens:  &clientsgcp.KubernetesTokenRequester{Clientset: clientset},
+			limiter: ratelimit.Shared(),
+		}))
+
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.CloudsqlUserKindAPIVersion, v1alpha2.Group))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.CloudsqlUser{}).
+		Complete(r)
+}
+
+type cloudsqlUserConnector struct {
+	kube         client.Client
+	tokens       clientsgcp.TokenRequester
+	limiter      *ratelimit.Limiter
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*sqladmin.Service, error)
+}
+
+func (c *cloudsqlUserConnector) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha2.CloudsqlUser)
+	if !ok {
+		return nil, errors.New(errNotCloudsqlUser)
+	}
+
+	provider := &apisv1alpha2.Provider{}
+	n := meta.NamespacedNameOf(cr.Spec.ProviderReference)
+	if err := c.kube.Get(ctx, n, provider); err != nil {
+		return nil, errors.Wrap(err, errUserProviderNotRetrieved)
+	}
+
+	opts, err := clientsgcp.ClientOptions(ctx, c.kube, c.tokens, provider, sqladmin.SqlserviceAdminScope)
+	if err != nil {
+		return nil, errors.Wrap(err, errUserClientOptionsFailed)
+	}
+	if c.newServiceFn == nil {
+		c.newServiceFn = sqladmin.NewService
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errUserNewClientFailed)
+	}
+
+	instance := &v1alpha2.CloudsqlInstance{}
+	in := types.NamespacedName{Name: cr.Spec.ForProvider.InstanceRef.Name, Namespace: cr.Namespace}
+	if err := c.kube.Get(ctx, in, instance); err != nil {
+		return nil, errors.Wrap(err, errUserInstanceNotRetrieved)
+	}
+
+	mutating, read := ratelimit.LimitsFromSpec(provider.Spec.RateLimit)
+	return &cloudsqlUserExternal{
+		kube:      c.kube,
+		user:      s.Users,
+		instance:  meta.GetExternalName(instance),
+		projectID: provider.Spec.ProjectID,
+		limiter:   c.limiter,
+		mutating:  mutating,
+		read:      read,
+	}, nil
+}
+
+type cloudsqlUserExternal struct {
+	kube      client.Client
+	user      *sqladmin.UsersService
+	projectID string
+	instance  string
+
+	limiter  *ratelimit.Limiter
+	mutating ratelimit.Limits
+	read     ratelimit.Limits
+}
+
+// wait acquires a rate limit token for the supplied Cloud SQL Admin API verb
+// before the caller issues the corresponding API call.
+func (c *cloudsqlUserExternal) wait(ctx context.Context, verb string) error {
+	return c.limiter.Wait(ctx, c.projectID, sqladminService, verb, c.mutating, c.read)
+}
+
+func (c *cloudsqlUserExternal) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha2.CloudsqlUser)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errNotCloudsqlUser)
+	}
+	if err := c.wait(ctx, "List"); err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	users, err := c.user.List(c.projectID, c.instance).Context(ctx).Do()
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errUserListFailed)
+	}
+	observed := cloudsql.FindUser(users.Items, cr.Spec.ForProvider.Name)
+	if observed == nil {
+		return resource.ExternalObservation{ResourceExists: false}, nil
+	}
+	checksum := cr.Status.AtProvider.PasswordChecksum
+	cr.Status.AtProvider = cloudsql.GenerateUserObservation(*observed)
+	cr.Status.AtProvider.PasswordChecksum = checksum
+	cr.Status.SetConditions(v1alpha1.Available())
+	if !resource.IsBound(cr) {
+		resource.SetBindable(cr)
+	}
+
+	conn, err := c.connectionDetails(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
+	var desiredPassword string
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		if desiredPassword, err = c.password(ctx, cr); err != nil {
+			return resource.ExternalObservation{}, err
+		}
+	}
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  cloudsql.IsUserUpToDate(cr.Spec.ForProvider, *observed, desiredPassword, checksum),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (c *cloudsqlUserExternal) Create(ctx context.Context, mg resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha2.CloudsqlUser)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errNotCloudsqlUser)
+	}
+	password, err := c.password(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	u := cloudsql.GenerateUser(cr.Spec.ForProvider)
+	u.Password = password
+
+	if err := c.wait(ctx, "Insert"); err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	_, err = c.user.Insert(c.projectID, c.instance, u).Context(ctx).Do()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errUserInsertFailed)
+	}
+	cr.Status.AtProvider.PasswordChecksum = cloudsql.HashPassword(password)
+	conn := resource.ConnectionDetails{
+		v1alpha1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.Name),
+		v1alpha1.ResourceCredentialsSecretPasswordKey: []byte(password),
+	}
+	endpoint, err := c.instanceEndpoint(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	if endpoint != "" {
+		conn[v1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(endpoint)
+	}
+	return resource.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (c *cloudsqlUserExternal) Update(ctx context.Context, mg resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha2.CloudsqlUser)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errNotCloudsqlUser)
+	}
+	conn, err := c.connectionDetails(ctx, cr)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	if conn == nil {
+		conn = resource.ConnectionDetails{}
+	}
+	// conn already resolves the desired password when PasswordSecretRef is
+	// set. Without one, reuse whatever password was last published rather
+	// than minting a new one on every Update -- otherwise an unrelated
+	// change, such as to Host, would rotate the password out from under the
+	// already-published connection Secret.
+	password := string(conn[v1alpha1.ResourceCredentialsSecretPasswordKey])
+	if password == "" {
+		if password, err = c.password(ctx, cr); err != nil {
+			return resource.ExternalUpdate{}, err
+		}
+		conn[v1alpha1.ResourceCredentialsSecretUserKey] = []byte(cr.Spec.ForProvider.Name)
+		conn[v1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(password)
+	}
+	u := cloudsql.GenerateUser(cr.Spec.ForProvider)
+	u.Password = password
+
+	if err := c.wait(ctx, "Update"); err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	_, err = c.user.Update(c.projectID, c.instance, cr.Spec.ForProvider.Name, u).
+		Host(cr.Status.AtProvider.Host).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrap(err, errUserUpdateFailed)
+	}
+	cr.Status.AtProvider.PasswordChecksum = cloudsql.HashPassword(password)
+	return resource.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (c *cloudsqlUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha2.CloudsqlUser)
+	if !ok {
+		return errors.New(errNotCloudsqlUser)
+	}
+	if err := c.wait(ctx, "Delete"); err != nil {
+		return err
+	}
+	_, err := c.user.Delete(c.projectID, c.instance).
+		Name(cr.Spec.ForProvider.Name).
+		Host(cr.Spec.ForProvider.Host).
+		Context(ctx).
+		Do()
+	if gcp.IsErrorNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errUserDeleteFailed)
+}
+
+// password resolves the password to use for this user, preferring the
+// referenced Secret and falling back to generating one.
+func (c *cloudsqlUserExternal) password(ctx context.Context, cr *v1alpha2.CloudsqlUser) (string, error) {
+	if cr.Spec.ForProvider.PasswordSecretRef == nil {
+		password, err := util.GeneratePassword(v1alpha2.PasswordLength)
+		return password, errors.Wrap(err, errUserGeneratePasswordFailed)
+	}
+	s := &v1.Secret{}
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	n := meta.NamespacedNameOf(&v1.ObjectReference{Name: ref.Name, Namespace: cr.Namespace})
+	if err := c.kube.Get(ctx, n, s); err != nil {
+		return "", errors.Wrap(err, errUserPasswordNotRetrieved)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+// connectionDetails publishes this user's connection details. The password
+// is only known at Create time (or when PasswordSecretRef is set), so on
+// subsequent Observes it is read back from the existing connection Secret.
+func (c *cloudsqlUserExternal) connectionDetails(ctx context.Context, cr *v1alpha2.CloudsqlUser) (resource.ConnectionDetails, error) {
+	conn := resource.ConnectionDetails{}
+	if cr.Spec.ForProvider.PasswordSecretRef != nil {
+		password, err := c.password(ctx, cr)
+		if err != nil {
+			return nil, err
+		}
+		conn[v1alpha1.ResourceCredentialsSecretUserKey] = []byte(cr.Spec.ForProvider.Name)
+		conn[v1alpha1.ResourceCredentialsSecretPasswordKey] = []byte(password)
+	} else if cr.Spec.WriteConnectionSecretToReference != nil {
+		s := &v1.Secret{}
+		n := types.NamespacedName{Name: cr.Spec.WriteConnectionSecretToReference.Name, Namespace: cr.Namespace}
+		if err := c.kube.Get(ctx, n, s); resource.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		conn[v1alpha1.ResourceCredentialsSecretUserKey] = []byte(cr.Spec.ForProvider.Name)
+		conn[v1alpha1.ResourceCredentialsSecretPasswordKey] = s.Data[v1alpha1.ResourceCredentialsSecretPasswordKey]
+	} else {
+		return nil, nil
+	}
+	endpoint, err := c.instanceEndpoint(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != "" {
+		conn[v1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(endpoint)
+	}
+	return conn, nil
+}
+
+// instanceEndpoint resolves the IP address of the CloudsqlInstance this user
+// belongs to, preferring its private IP, matching the endpoint precedence
+// CloudsqlInstance.getConnectionDetails uses for its own connection secret.
+func (c *cloudsqlUserExternal) instanceEndpoint(ctx context.Context, cr *v1alpha2.CloudsqlUser) (string, error) {
+	instance := &v1alpha2.CloudsqlInstance{}
+	n := types.NamespacedName{Name: cr.Spec.ForProvider.InstanceRef.Name, Namespace: cr.Namespace}
+	if err := c.kube.Get(ctx, n, instance); err != nil {
+		return "", errors.Wrap(err, errUserInstanceNotRetrieved)
+	}
+	endpoint := ""
+	for _, ip := range instance.Status.AtProvider.IPAddresses {
+		if ip.Type == v1alpha2.PrivateIPType {
+			return ip.IPAddress, nil
+		}
+		if ip.Type == v1alpha2.PublicIPType && endpoint == "" {
+			endpoint = ip.IPAddress
+		}
+	}
+	return endpoint, nil
+}
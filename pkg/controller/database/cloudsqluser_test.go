@@ -0,0 +1,253 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplaneio/stack-gcp/apis/database/v1alpha2"
+)
+
+// mockClient is a minimal client.Client whose Get is overridable; every
+// other method panics if called, since the connectionDetails/password logic
+// under test only ever reads Secrets and the referenced CloudsqlInstance.
+type mockClient struct {
+	client.Client
+	MockGet func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error
+}
+
+func (c *mockClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	return c.MockGet(ctx, key, obj)
+}
+
+func withInstancePrivateIP(ip string) func(*v1alpha2.CloudsqlInstance) {
+	return func(i *v1alpha2.CloudsqlInstance) {
+		i.Status.AtProvider.IPAddresses = append(i.Status.AtProvider.IPAddresses, v1alpha2.IPAddress{
+			Type:      v1alpha2.PrivateIPType,
+			IPAddress: ip,
+		})
+	}
+}
+
+func withInstancePublicIP(ip string) func(*v1alpha2.CloudsqlInstance) {
+	return func(i *v1alpha2.CloudsqlInstance) {
+		i.Status.AtProvider.IPAddresses = append(i.Status.AtProvider.IPAddresses, v1alpha2.IPAddress{
+			Type:      v1alpha2.PublicIPType,
+			IPAddress: ip,
+		})
+	}
+}
+
+func TestInstanceEndpoint(t *testing.T) {
+	cr := &v1alpha2.CloudsqlUser{}
+	cr.Namespace = "coolNamespace"
+	cr.Spec.ForProvider.InstanceRef.Name = "coolInstance"
+
+	cases := map[string]struct {
+		instanceOpts []func(*v1alpha2.CloudsqlInstance)
+		getErr       error
+		want         string
+		wantErr      bool
+	}{
+		"PrefersPrivateIP": {
+			instanceOpts: []func(*v1alpha2.CloudsqlInstance){
+				withInstancePublicIP("8.8.8.8"),
+				withInstancePrivateIP("10.0.0.2"),
+			},
+			want: "10.0.0.2",
+		},
+		"FallsBackToPublicIP": {
+			instanceOpts: []func(*v1alpha2.CloudsqlInstance){withInstancePublicIP("8.8.8.8")},
+			want:         "8.8.8.8",
+		},
+		"NoIPsYet": {
+			want: "",
+		},
+		"InstanceNotRetrieved": {
+			getErr:  errBoom,
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			instance := &v1alpha2.CloudsqlInstance{}
+			for _, o := range tc.instanceOpts {
+				o(instance)
+			}
+			kube := &mockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				if tc.getErr != nil {
+					return tc.getErr
+				}
+				*obj.(*v1alpha2.CloudsqlInstance) = *instance
+				return nil
+			}}
+			c := &cloudsqlUserExternal{kube: kube}
+
+			got, err := c.instanceEndpoint(context.Background(), cr)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("instanceEndpoint(...): wantErr %t, got error %v", tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("instanceEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+var errBoom = errBoomError{}
+
+type errBoomError struct{}
+
+func (errBoomError) Error() string { return "boom" }
+
+func withPasswordSecretRef() *v1alpha2.CloudsqlUser {
+	c := &v1alpha2.CloudsqlUser{}
+	c.Namespace = "coolNamespace"
+	c.Spec.ForProvider.PasswordSecretRef = &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "coolSecret"},
+		Key:                  "password",
+	}
+	return c
+}
+
+func TestPassword(t *testing.T) {
+	noRef := &v1alpha2.CloudsqlUser{}
+	noRef.Namespace = "coolNamespace"
+
+	cases := map[string]struct {
+		cr      *v1alpha2.CloudsqlUser
+		getFn   func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error
+		want    string
+		wantErr bool
+	}{
+		"NoPasswordSecretRefGeneratesPassword": {
+			cr: noRef,
+			getFn: func(_ context.Context, _ client.ObjectKey, _ runtime.Object) error {
+				t.Fatal("Get should not be called when PasswordSecretRef is nil")
+				return nil
+			},
+		},
+		"PasswordSecretRefRead": {
+			cr: withPasswordSecretRef(),
+			getFn: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				s := obj.(*v1.Secret)
+				s.Data = map[string][]byte{"password": []byte("cool-password")}
+				return nil
+			},
+			want: "cool-password",
+		},
+		"PasswordSecretRefNotFound": {
+			cr: withPasswordSecretRef(),
+			getFn: func(_ context.Context, _ client.ObjectKey, _ runtime.Object) error {
+				return errBoom
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &cloudsqlUserExternal{kube: &mockClient{MockGet: tc.getFn}}
+			got, err := c.password(context.Background(), tc.cr)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("password(...): wantErr %t, got error %v", tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if tc.want != "" {
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("password(...): -want, +got:\n%s", diff)
+				}
+				return
+			}
+			if len(got) != v1alpha2.PasswordLength {
+				t.Errorf("generated password: want length %d, got %d", v1alpha2.PasswordLength, len(got))
+			}
+		})
+	}
+}
+
+func newCloudsqlUser() *v1alpha2.CloudsqlUser {
+	cr := &v1alpha2.CloudsqlUser{}
+	cr.Namespace = "coolNamespace"
+	cr.Spec.ForProvider.Name = "coolUser"
+	cr.Spec.ForProvider.InstanceRef.Name = "coolInstance"
+	return cr
+}
+
+func TestConnectionDetails(t *testing.T) {
+	instanceWithEndpoint := &v1alpha2.CloudsqlInstance{}
+	instanceWithEndpoint.Status.AtProvider.IPAddresses = []v1alpha2.IPAddress{
+		{Type: v1alpha2.PrivateIPType, IPAddress: "10.0.0.2"},
+	}
+
+	cases := map[string]struct {
+		cr    *v1alpha2.CloudsqlUser
+		getFn func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error
+		want  resource.ConnectionDetails
+	}{
+		"NoSecretRefsReturnsNothing": {
+			cr:   newCloudsqlUser(),
+			want: nil,
+		},
+		"WriteConnectionSecretPublishesEndpoint": {
+			cr: func() *v1alpha2.CloudsqlUser {
+				c := newCloudsqlUser()
+				c.Spec.WriteConnectionSecretToReference = &v1alpha1.SecretReference{Name: "coolConnSecret"}
+				return c
+			}(),
+			getFn: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+				switch o := obj.(type) {
+				case *v1.Secret:
+					o.Data = map[string][]byte{v1alpha1.ResourceCredentialsSecretPasswordKey: []byte("cool-password")}
+				case *v1alpha2.CloudsqlInstance:
+					*o = *instanceWithEndpoint
+				}
+				return nil
+			},
+			want: resource.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretUserKey:     []byte("coolUser"),
+				v1alpha1.ResourceCredentialsSecretPasswordKey: []byte("cool-password"),
+				v1alpha1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &cloudsqlUserExternal{kube: &mockClient{MockGet: tc.getFn}}
+			got, err := c.connectionDetails(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("connectionDetails(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("connectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
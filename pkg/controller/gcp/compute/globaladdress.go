@@ -0,0 +1,201 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplaneio/stack-gcp/gcp/apis/compute/v1alpha2"
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/gcp/apis/v1alpha2"
+	clients "github.com/crossplaneio/stack-gcp/pkg/clients/gcp"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/gcp/ratelimit"
+)
+
+// Error strings.
+const (
+	errNotGlobalAddress        = "managed resource is not a GlobalAddress resource"
+	errResolveGlobalAddressRef = "cannot resolve GlobalAddress's NetworkRef/NetworkSelector"
+)
+
+// GlobalAddressController is the controller for GlobalAddress CRD.
+type GlobalAddressController struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *GlobalAddressController) SetupWithManager(mgr ctrl.Manager) error {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes clientset")
+	}
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.GlobalAddressGroupVersionKind),
+		resource.WithExternalConnecter(&globalAddressConnector{
+			client:  mgr.GetClient(),
+			tokens:  &clients.KubernetesTokenRequester{Clientset: clientset},
+			limiter: ratelimit.Shared(),
+		}),
+		resource.WithManagedConnectionPublishers())
+
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.GlobalAddressKindAPIVersion, v1alpha2.Group))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.GlobalAddress{}).
+		Complete(r)
+}
+
+type globalAddressConnector struct {
+	client      client.Client
+	tokens      clients.TokenRequester
+	limiter     *ratelimit.Limiter
+	newClientFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *globalAddressConnector) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha2.GlobalAddress)
+	if !ok {
+		return nil, errors.New(errNotGlobalAddress)
+	}
+
+	provider := &apisv1alpha2.Provider{}
+	n := meta.NamespacedNameOf(cr.Spec.ProviderReference)
+	if err := c.client.Get(ctx, n, provider); err != nil {
+		return nil, errors.Wrapf(err, "cannot get provider %s", n)
+	}
+
+	opts, err := clients.ClientOptions(ctx, c.client, c.tokens, provider, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	if c.newClientFn == nil {
+		c.newClientFn = googlecompute.NewService
+	}
+	s, err := c.newClientFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	mutating, read := ratelimit.LimitsFromSpec(provider.Spec.RateLimit)
+	return &globalAddressExternal{
+		Service:   s,
+		kube:      c.client,
+		namespace: cr.GetNamespace(),
+		projectID: provider.Spec.ProjectID,
+		limiter:   c.limiter,
+		mutating:  mutating,
+		read:      read,
+	}, nil
+}
+
+type globalAddressExternal struct {
+	*googlecompute.Service
+	kube      client.Client
+	namespace string
+	projectID string
+
+	limiter  *ratelimit.Limiter
+	mutating ratelimit.Limits
+	read     ratelimit.Limits
+}
+
+// wait acquires a rate limit token for the supplied Compute Engine verb
+// before the caller issues the corresponding API call.
+func (c *globalAddressExternal) wait(ctx context.Context, verb string) error {
+	return c.limiter.Wait(ctx, c.projectID, computeService, verb, c.mutating, c.read)
+}
+
+func (c *globalAddressExternal) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha2.GlobalAddress)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errNotGlobalAddress)
+	}
+	if err := c.wait(ctx, "Get"); err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	observed, err := c.GlobalAddresses.Get(c.projectID, cr.Spec.Name).Context(ctx).Do()
+	if clients.IsErrorNotFound(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	cr.Status.GCPGlobalAddressStatus = *v1alpha2.GenerateGCPGlobalAddressStatus(*observed)
+	return resource.ExternalObservation{
+		// GlobalAddresses are immutable in the Compute Engine API (see
+		// Update, below), so an existing one is always up to date.
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *globalAddressExternal) Create(ctx context.Context, mg resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha2.GlobalAddress)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errNotGlobalAddress)
+	}
+	network, err := clients.ResolveNetwork(ctx, c.kube, c.namespace, cr.Spec.NetworkRef, cr.Spec.NetworkSelector)
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errResolveGlobalAddressRef)
+	}
+	if err := c.wait(ctx, "Insert"); err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	if _, err := c.GlobalAddresses.Insert(
+		c.projectID,
+		v1alpha2.GenerateGlobalAddress(cr.Spec.GCPGlobalAddressSpec, network.Status.SelfLink)).
+		Context(ctx).
+		Do(); err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	return resource.ExternalCreation{}, nil
+}
+
+// Update is a no-op. Global addresses are immutable in the Compute Engine
+// API -- any change to a GlobalAddress's parameters requires it to be
+// deleted and recreated.
+func (c *globalAddressExternal) Update(ctx context.Context, mg resource.Managed) (resource.ExternalUpdate, error) {
+	return resource.ExternalUpdate{}, nil
+}
+
+func (c *globalAddressExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha2.GlobalAddress)
+	if !ok {
+		return errors.New(errNotGlobalAddress)
+	}
+	if err := c.wait(ctx, "Delete"); err != nil {
+		return err
+	}
+	if _, err := c.GlobalAddresses.Delete(c.projectID, cr.Spec.Name).
+		Context(ctx).
+		Do(); !clients.IsErrorNotFound(err) && err != nil {
+		return err
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	googlecompute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/crossplaneio/stack-gcp/gcp/apis/compute/v1alpha2"
 	apisv1alpha2 "github.com/crossplaneio/stack-gcp/gcp/apis/v1alpha2"
 	clients "github.com/crossplaneio/stack-gcp/pkg/clients/gcp"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/gcp/ratelimit"
 )
 
 const (
@@ -40,6 +42,10 @@ const (
 	errNewClient    = "cannot create new Compute Service"
 	errNotNetwork   = "managed resource is not a Network resource"
 	errNameNotGiven = "name for networkExternal resource is not provided"
+
+	// computeService identifies the Compute Engine API to the shared rate
+	// limiter.
+	computeService = "compute.googleapis.com"
 )
 
 // NetworkController is the controller for Network CRD.
@@ -48,9 +54,17 @@ type NetworkController struct{}
 // SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func (c *NetworkController) SetupWithManager(mgr ctrl.Manager) error {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes clientset")
+	}
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.NetworkGroupVersionKind),
-		resource.WithExternalConnecter(&networkConnector{client: mgr.GetClient()}),
+		resource.WithExternalConnecter(&networkConnector{
+			client:  mgr.GetClient(),
+			tokens:  &clients.KubernetesTokenRequester{Clientset: clientset},
+			limiter: ratelimit.Shared(),
+		}),
 		resource.WithManagedConnectionPublishers())
 
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.NetworkKindAPIVersion, v1alpha2.Group))
@@ -63,6 +77,8 @@ func (c *NetworkController) SetupWithManager(mgr ctrl.Manager) error {
 
 type networkConnector struct {
 	client      client.Client
+	tokens      clients.TokenRequester
+	limiter     *ratelimit.Limiter
 	newClientFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
 }
 
@@ -85,23 +101,40 @@ func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (re
 		return nil, errors.Wrapf(err, "cannot get provider %s", n)
 	}
 
-	gcpCreds, err := clients.ProviderCredentials(c.client, provider, googlecompute.ComputeScope)
+	opts, err := clients.ClientOptions(ctx, c.client, c.tokens, provider, googlecompute.ComputeScope)
 	if err != nil {
 		return nil, err
 	}
 	if c.newClientFn == nil {
 		c.newClientFn = googlecompute.NewService
 	}
-	s, err := c.newClientFn(ctx, option.WithCredentials(gcpCreds))
+	s, err := c.newClientFn(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &networkExternal{Service: s, projectID: provider.Spec.ProjectID}, nil
+	mutating, read := ratelimit.LimitsFromSpec(provider.Spec.RateLimit)
+	return &networkExternal{
+		Service:   s,
+		projectID: provider.Spec.ProjectID,
+		limiter:   c.limiter,
+		mutating:  mutating,
+		read:      read,
+	}, nil
 }
 
 type networkExternal struct {
 	*googlecompute.Service
 	projectID string
+
+	limiter  *ratelimit.Limiter
+	mutating ratelimit.Limits
+	read     ratelimit.Limits
+}
+
+// wait acquires a rate limit token for the supplied Compute Engine verb
+// before the caller issues the corresponding API call.
+func (c *networkExternal) wait(ctx context.Context, verb string) error {
+	return c.limiter.Wait(ctx, c.projectID, computeService, verb, c.mutating, c.read)
 }
 
 func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
@@ -109,6 +142,9 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (res
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errNotNetwork)
 	}
+	if err := c.wait(ctx, "Get"); err != nil {
+		return resource.ExternalObservation{}, err
+	}
 	observed, err := c.Networks.Get(c.projectID, cr.Spec.Name).Context(ctx).Do()
 	if clients.IsErrorNotFound(err) {
 		return resource.ExternalObservation{
@@ -129,6 +165,9 @@ func (c *networkExternal) Create(ctx context.Context, mg resource.Managed) (reso
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errNotNetwork)
 	}
+	if err := c.wait(ctx, "Insert"); err != nil {
+		return resource.ExternalCreation{}, err
+	}
 	if _, err := c.Networks.Insert(c.projectID, v1alpha2.GenerateNetwork(cr.Spec.GCPNetworkSpec)).
 		Context(ctx).
 		Do(); err != nil {
@@ -142,6 +181,9 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (reso
 	if !ok {
 		return resource.ExternalUpdate{}, errors.New(errNotNetwork)
 	}
+	if err := c.wait(ctx, "Patch"); err != nil {
+		return resource.ExternalUpdate{}, err
+	}
 	if _, err := c.Networks.Patch(
 		c.projectID,
 		cr.Spec.Name,
@@ -158,10 +200,13 @@ func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error
 	if !ok {
 		return errors.New(errNotNetwork)
 	}
+	if err := c.wait(ctx, "Delete"); err != nil {
+		return err
+	}
 	if _, err := c.Networks.Delete(c.projectID, cr.Spec.Name).
 		Context(ctx).
 		Do(); !clients.IsErrorNotFound(err) && err != nil {
 		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicenetworking reconciles the Connection managed resource,
+// which represents a private VPC peering connection to a Google-managed
+// service such as Cloud SQL.
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	servicenetworking "google.golang.org/api/servicenetworking/v1"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	computev1alpha2 "github.com/crossplaneio/stack-gcp/gcp/apis/compute/v1alpha2"
+	"github.com/crossplaneio/stack-gcp/gcp/apis/servicenetworking/v1alpha2"
+	apisv1alpha2 "github.com/crossplaneio/stack-gcp/gcp/apis/v1alpha2"
+	clients "github.com/crossplaneio/stack-gcp/pkg/clients/gcp"
+	"github.com/crossplaneio/stack-gcp/pkg/clients/gcp/ratelimit"
+)
+
+const (
+	// Error strings.
+	errNewClient      = "cannot create new Service Networking Service"
+	errNotConnection  = "managed resource is not a Connection resource"
+	errResolveConnRef = "cannot resolve Connection's NetworkRef/NetworkSelector"
+	errWaitOperation  = "service networking operation did not complete"
+
+	// networkingService identifies the Service Networking API to the shared
+	// rate limiter.
+	networkingService = "servicenetworking.googleapis.com"
+
+	operationPollInterval = 5 * time.Second
+)
+
+// ConnectionController is the controller for Connection CRD.
+type ConnectionController struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *ConnectionController) SetupWithManager(mgr ctrl.Manager) error {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes clientset")
+	}
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.ConnectionGroupVersionKind),
+		resource.WithExternalConnecter(&connectionConnector{
+			client:  mgr.GetClient(),
+			tokens:  &clients.KubernetesTokenRequester{Clientset: clientset},
+			limiter: ratelimit.Shared(),
+		}),
+		resource.WithManagedConnectionPublishers())
+
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.ConnectionKindAPIVersion, v1alpha2.Group))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.Connection{}).
+		Complete(r)
+}
+
+type connectionConnector struct {
+	client      client.Client
+	tokens      clients.TokenRequester
+	limiter     *ratelimit.Limiter
+	newClientFn func(ctx context.Context, opts ...option.ClientOption) (*servicenetworking.APIService, error)
+}
+
+func (c *connectionConnector) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha2.Connection)
+	if !ok {
+		return nil, errors.New(errNotConnection)
+	}
+
+	provider := &apisv1alpha2.Provider{}
+	n := meta.NamespacedNameOf(cr.Spec.ProviderReference)
+	if err := c.client.Get(ctx, n, provider); err != nil {
+		return nil, errors.Wrapf(err, "cannot get provider %s", n)
+	}
+
+	opts, err := clients.ClientOptions(ctx, c.client, c.tokens, provider, servicenetworking.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	if c.newClientFn == nil {
+		c.newClientFn = servicenetworking.NewService
+	}
+	s, err := c.newClientFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	mutating, read := ratelimit.LimitsFromSpec(provider.Spec.RateLimit)
+	return &connectionExternal{
+		APIService: s,
+		kube:       c.client,
+		namespace:  cr.GetNamespace(),
+		projectID:  provider.Spec.ProjectID,
+		limiter:    c.limiter,
+		mutating:   mutating,
+		read:       read,
+	}, nil
+}
+
+type connectionExternal struct {
+	*servicenetworking.APIService
+	kube      client.Client
+	namespace string
+	projectID string
+
+	limiter  *ratelimit.Limiter
+	mutating ratelimit.Limits
+	read     ratelimit.Limits
+}
+
+// wait acquires a rate limit token for the supplied Service Networking verb
+// before the caller issues the corresponding API call.
+func (c *connectionExternal) wait(ctx context.Context, verb string) error {
+	return c.limiter.Wait(ctx, c.projectID, networkingService, verb, c.mutating, c.read)
+}
+
+func (c *connectionExternal) service(cr *v1alpha2.Connection) string {
+	if cr.Spec.Service != "" {
+		return cr.Spec.Service
+	}
+	return v1alpha2.DefaultService
+}
+
+func (c *connectionExternal) network(ctx context.Context, cr *v1alpha2.Connection) (*computev1alpha2.Network, error) {
+	n, err := clients.ResolveNetwork(ctx, c.kube, c.namespace, cr.Spec.NetworkRef, cr.Spec.NetworkSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveConnRef)
+	}
+	return n, nil
+}
+
+// waitForOperation polls a long-running Service Networking operation until
+// it completes or the context is cancelled.
+func (c *connectionExternal) waitForOperation(ctx context.Context, name string) error {
+	for {
+		op, err := c.Operations.Get(name).Context(ctx).Do()
+		if err != nil {
+			return errors.Wrap(err, errWaitOperation)
+		}
+		if op.Done {
+			if op.Error != nil {
+				return errors.New(op.Error.Message)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
+
+func (c *connectionExternal) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha2.Connection)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errNotConnection)
+	}
+	network, err := c.network(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if err := c.wait(ctx, "List"); err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	parent := fmt.Sprintf("services/%s", c.service(cr))
+	found, err := c.Services.Connections.List(parent).Network(network.Status.SelfLink).Context(ctx).Do()
+	if clients.IsErrorNotFound(err) {
+		return resource.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if len(found.Connections) == 0 {
+		return resource.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed := found.Connections[0]
+	cr.Status.GCPConnectionStatus = *v1alpha2.GenerateGCPConnectionStatus(*observed)
+	ranges, err := c.reservedRanges(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	return resource.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: reflect.DeepEqual(ranges, observed.ReservedPeeringRanges),
+	}, nil
+}
+
+func (c *connectionExternal) Create(ctx context.Context, mg resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha2.Connection)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errNotConnection)
+	}
+	network, err := c.network(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	ranges, err := c.reservedRanges(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	if err := c.wait(ctx, "Create"); err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	parent := fmt.Sprintf("services/%s", c.service(cr))
+	conn := v1alpha2.GenerateConnection(cr.Spec.GCPConnectionSpec, network.Status.SelfLink, ranges)
+	op, err := c.Services.Connections.Create(parent, conn).Context(ctx).Do()
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+	return resource.ExternalCreation{}, c.waitForOperation(ctx, op.Name)
+}
+
+func (c *connectionExternal) Update(ctx context.Context, mg resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha2.Connection)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errNotConnection)
+	}
+	network, err := c.network(ctx, cr)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	ranges, err := c.reservedRanges(ctx, cr)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	if err := c.wait(ctx, "Patch"); err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	parent := fmt.Sprintf("services/%s", c.service(cr))
+	conn := v1alpha2.GenerateConnection(cr.Spec.GCPConnectionSpec, network.Status.SelfLink, ranges)
+	op, err := c.Services.Connections.Patch(parent, network.Status.SelfLink, conn).Force(true).Context(ctx).Do()
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+	return resource.ExternalUpdate{}, c.waitForOperation(ctx, op.Name)
+}
+
+// Delete is a no-op. The Service Networking API does not expose an
+// operation to tear down a private connection -- Google recommends leaving
+// it in place, since other resources (e.g. other CloudsqlInstances) are
+// likely to depend on the same peering range.
+func (c *connectionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
+
+func (c *connectionExternal) reservedRanges(ctx context.Context, cr *v1alpha2.Connection) ([]string, error) {
+	names := make([]string, 0, len(cr.Spec.ReservedPeeringRangeRefs))
+	for _, ref := range cr.Spec.ReservedPeeringRangeRefs {
+		a := &computev1alpha2.GlobalAddress{}
+		if err := c.kube.Get(ctx, client.ObjectKey{Namespace: cr.GetNamespace(), Name: ref.Name}, a); err != nil {
+			return nil, errors.Wrapf(err, "cannot get referenced GlobalAddress %s", ref.Name)
+		}
+		names = append(names, a.Spec.Name)
+	}
+	return names, nil
+}